@@ -4,24 +4,38 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/gorilla/mux"
 	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/auth"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/clusters"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/jobs"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/logs"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/tenantpatch"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/kubernetes"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 var (
-	scheme = runtime.NewScheme()
-	k8sClient client.Client
+	scheme           = runtime.NewScheme()
+	k8sClient        client.Client
+	clientset        kubernetes.Interface
+	provisioningPool *jobs.Pool
+	clusterRegistry  *clusters.Registry
+	clusterScheduler *clusters.Scheduler
+	placementIndex   *clusters.PlacementIndex
 )
 
 func init() {
@@ -40,6 +54,8 @@ type TenantCreateRequest struct {
 	Domains          []string          `json:"domains,omitempty"`
 	Features         map[string]bool   `json:"features,omitempty"`
 	Metadata         map[string]string `json:"metadata,omitempty"`
+	ClusterName      string            `json:"clusterName,omitempty"`
+	PlacementPolicy  string            `json:"placementPolicy,omitempty"`
 }
 
 type ResourceSpec struct {
@@ -88,18 +104,49 @@ func main() {
 		log.Fatalf("Failed to create Kubernetes client: %v", err)
 	}
 
+	clientset, err = kubernetes.NewForConfig(config)
+	if err != nil {
+		log.Fatalf("Failed to create Kubernetes clientset: %v", err)
+	}
+
+	// Cluster registry starts with the in-process client as the "default" cluster, then
+	// loads any additional clusters named in CLUSTER_KUBECONFIGS (name=path,name2=path2).
+	clusterRegistry = clusters.NewRegistry(scheme)
+	clusterRegistry.Add("default", k8sClient, clientset)
+	if err := clusterRegistry.LoadFromEnv("CLUSTER_KUBECONFIGS"); err != nil {
+		log.Fatalf("Failed to load cluster registry: %v", err)
+	}
+	clusterScheduler = clusters.NewScheduler(clusterRegistry)
+	placementIndex = clusters.NewPlacementIndex()
+
+	provisioningPool = jobs.NewPool(jobs.NewMemoryStore(), 4, provisionTenant)
+
+	var jwks *auth.JWKSCache
+	if jwksURL := os.Getenv("JWT_JWKS_URL"); jwksURL != "" {
+		jwks = auth.NewJWKSCache(jwksURL, 5*time.Minute)
+	}
+	jwtVerifier := auth.NewVerifier([]byte(os.Getenv("JWT_HMAC_SECRET")), jwks)
+	policyProvider := auth.NewStaticPolicyProvider()
+
 	// Setup HTTP routes
 	r := mux.NewRouter()
-	
-	// Health check
+
+	// Health check - unauthenticated
 	r.HandleFunc("/health", healthHandler).Methods("GET")
 	r.HandleFunc("/api/v1/health", healthHandler).Methods("GET")
-	
-	// Tenant management
-	r.HandleFunc("/api/v1/tenants", createTenantHandler).Methods("POST")
-	r.HandleFunc("/api/v1/tenants/{name}", getTenantHandler).Methods("GET")
-	r.HandleFunc("/api/v1/tenants/{name}", deleteTenantHandler).Methods("DELETE")
-	r.HandleFunc("/api/v1/tenants", listTenantsHandler).Methods("GET")
+
+	// Tenant management - requires a valid bearer token and per-tenant authorization
+	tenants := r.PathPrefix("/api/v1").Subrouter()
+	tenants.HandleFunc("/tenants", createTenantHandler).Methods("POST").Name("createTenant")
+	tenants.HandleFunc("/tenants/{name}", getTenantHandler).Methods("GET").Name("getTenant")
+	tenants.HandleFunc("/tenants/{name}", deleteTenantHandler).Methods("DELETE").Name("deleteTenant")
+	tenants.HandleFunc("/tenants/{name}", patchTenantHandler).Methods("PATCH").Name("patchTenant")
+	tenants.HandleFunc("/tenants", listTenantsHandler).Methods("GET").Name("listTenants")
+	tenants.HandleFunc("/tenants/{name}/logs", tenantLogsHandler).Methods("GET").Name("tenantLogs")
+	tenants.HandleFunc("/tenants/jobs/{jobId}", jobStatusHandler).Methods("GET").Name("jobStatus")
+	tenants.HandleFunc("/clusters", listClustersHandler).Methods("GET").Name("listClusters")
+	tenants.Use(jwtVerifier.Middleware)
+	tenants.Use(auth.AuthzMiddleware(policyProvider))
 
 	// CORS middleware
 	r.Use(corsMiddleware)
@@ -133,10 +180,11 @@ func healthHandler(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, http.StatusOK, response)
 }
 
-// Create tenant handler
+// Create tenant handler - queues the tenant creation as an async provisioning job and
+// returns 202 Accepted with a jobId the caller can poll for status.
 func createTenantHandler(w http.ResponseWriter, r *http.Request) {
 	log.Printf("📥 Creating tenant - Method: %s, URL: %s", r.Method, r.URL.Path)
-	
+
 	var req TenantCreateRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		log.Printf("❌ Failed to decode request: %v", err)
@@ -146,7 +194,32 @@ func createTenantHandler(w http.ResponseWriter, r *http.Request) {
 
 	log.Printf("📋 Tenant creation request: %+v", req)
 
-	// Create Kubernetes Tenant resource
+	job := provisioningPool.Enqueue(req.Name, req)
+	log.Printf("📨 Queued provisioning job %s for tenant %s", job.ID, req.Name)
+
+	response := TenantResponse{
+		Success: true,
+		Data: map[string]interface{}{
+			"jobId":  job.ID,
+			"name":   req.Name,
+			"status": string(job.Status),
+		},
+		Message: fmt.Sprintf("Tenant %s provisioning queued", req.Name),
+	}
+
+	writeJSONResponse(w, http.StatusAccepted, response)
+}
+
+// provisionTenant is the jobs.ProcessFunc that actually creates the Tenant CR in
+// Kubernetes. It is invoked by the worker pool, with retries handled by the pool itself.
+func provisionTenant(ctx context.Context, tenantName string, payload interface{}, report jobs.EventReporter) error {
+	req, ok := payload.(TenantCreateRequest)
+	if !ok {
+		return fmt.Errorf("unexpected payload type for tenant %s", tenantName)
+	}
+
+	report("ValidatingSpec", "validating tenant spec")
+
 	tenant := &tenantv1alpha1.Tenant{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      req.Name,
@@ -186,29 +259,70 @@ func createTenantHandler(w http.ResponseWriter, r *http.Request) {
 		},
 	}
 
-	// Create the tenant in Kubernetes
-	ctx := context.Background()
-	if err := k8sClient.Create(ctx, tenant); err != nil {
-		log.Printf("❌ Failed to create tenant in Kubernetes: %v", err)
-		writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to create tenant: %v", err))
+	target, err := clusterScheduler.Select(ctx, req.ClusterName, req.PlacementPolicy)
+	if err != nil {
+		return fmt.Errorf("failed to select target cluster: %w", err)
+	}
+
+	report("CreatingCR", fmt.Sprintf("creating Tenant custom resource on cluster %s", target.Name))
+	if err := target.Client.Create(ctx, tenant); err != nil {
+		return fmt.Errorf("failed to create tenant CR: %w", err)
+	}
+	placementIndex.Set(req.Name, target.Name)
+
+	report("WaitingForNamespace", "waiting for tenant controller to reconcile namespace and services")
+	log.Printf("✅ Tenant %s created successfully on cluster %s", req.Name, target.Name)
+	return nil
+}
+
+// tenantCluster resolves the cluster a tenant was placed on. The in-memory placementIndex
+// isn't persisted, so a process restart leaves it empty; when it has no record (or points
+// at a cluster no longer registered), tenantCluster falls back to searching every
+// registered cluster for the tenant CR, the same way listTenantsHandler does, before
+// finally falling back to the default cluster. This avoids silently routing a real
+// tenant's get/delete/patch to the wrong cluster after a restart.
+func tenantCluster(ctx context.Context, name string) *clusters.Cluster {
+	if clusterName, ok := placementIndex.Get(name); ok {
+		if c, ok := clusterRegistry.Get(clusterName); ok {
+			return c
+		}
+	}
+
+	for _, c := range clusterRegistry.List() {
+		var tenant tenantv1alpha1.Tenant
+		if err := c.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, &tenant); err == nil {
+			placementIndex.Set(name, c.Name)
+			return c
+		}
+	}
+
+	c, _ := clusterRegistry.Default()
+	return c
+}
+
+// Job status handler reports the queued/running/succeeded/failed state of a provisioning job.
+// The route carries no {name} segment, so AuthzMiddleware can't scope it by path alone;
+// tenant.admin callers are restricted here to jobs belonging to their own tenant.
+func jobStatusHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	jobID := vars["jobId"]
+
+	job, ok := provisioningPool.Get(jobID)
+	if !ok {
+		writeErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Job %s not found", jobID))
 		return
 	}
 
-	log.Printf("✅ Tenant %s created successfully in Kubernetes", req.Name)
+	if claims, ok := auth.ClaimsFromContext(r.Context()); ok && !claims.HasRole(auth.RolePlatformAdmin) && job.TenantName != claims.TenantID {
+		writeErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Job %s not found", jobID))
+		return
+	}
 
 	response := TenantResponse{
 		Success: true,
-		Data: map[string]interface{}{
-			"name":             req.Name,
-			"organizationName": req.OrganizationName,
-			"tier":             req.Tier,
-			"status":           "Creating",
-			"message":          "Tenant creation initiated",
-		},
-		Message: fmt.Sprintf("Tenant %s created successfully", req.Name),
+		Data:    job,
 	}
-
-	writeJSONResponse(w, http.StatusCreated, response)
+	writeJSONResponse(w, http.StatusOK, response)
 }
 
 // Get tenant handler
@@ -220,8 +334,9 @@ func getTenantHandler(w http.ResponseWriter, r *http.Request) {
 
 	tenant := &tenantv1alpha1.Tenant{}
 	ctx := context.Background()
-	
-	if err := k8sClient.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, tenant); err != nil {
+	cluster := tenantCluster(ctx, name)
+
+	if err := cluster.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, tenant); err != nil {
 		log.Printf("❌ Failed to get tenant %s: %v", name, err)
 		writeErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Tenant %s not found", name))
 		return
@@ -236,6 +351,82 @@ func getTenantHandler(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, http.StatusOK, response)
 }
 
+const maxPatchRetries = 5
+
+// patchTenantHandler applies a partial update to a tenant's spec using get-modify-update
+// with resourceVersion conflict detection, mirroring the etcd3 updateState retry pattern:
+// on a conflict we re-fetch and re-apply the patch rather than surfacing the race to the
+// caller, up to maxPatchRetries attempts.
+func patchTenantHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	name := vars["name"]
+	ctx := r.Context()
+
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		writeErrorResponse(w, http.StatusBadRequest, "Failed to read request body")
+		return
+	}
+
+	contentType := tenantpatch.ContentTypeMergePatch
+	if ct := r.Header.Get("Content-Type"); ct != "" {
+		contentType = ct
+	}
+
+	ifMatch := r.Header.Get("If-Match")
+	cluster := tenantCluster(ctx, name)
+
+	var (
+		tenant tenantv1alpha1.Tenant
+		diff   tenantpatch.Diff
+	)
+
+	for attempt := 1; attempt <= maxPatchRetries; attempt++ {
+		if err := cluster.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, &tenant); err != nil {
+			writeErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Tenant %s not found", name))
+			return
+		}
+
+		if ifMatch != "" && ifMatch != tenant.ResourceVersion {
+			writeErrorResponse(w, http.StatusPreconditionFailed, fmt.Sprintf("resourceVersion mismatch: If-Match %q does not match current %q", ifMatch, tenant.ResourceVersion))
+			return
+		}
+
+		newSpec, d, err := tenantpatch.Apply(tenant.Spec, body, contentType)
+		if err != nil {
+			writeErrorResponse(w, http.StatusBadRequest, fmt.Sprintf("Failed to apply patch: %v", err))
+			return
+		}
+		diff = d
+		tenant.Spec = newSpec
+
+		err = cluster.Client.Update(ctx, &tenant)
+		if err == nil {
+			break
+		}
+		if !apierrors.IsConflict(err) {
+			writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to update tenant: %v", err))
+			return
+		}
+		if attempt == maxPatchRetries {
+			writeErrorResponse(w, http.StatusConflict, fmt.Sprintf("Tenant %s was updated concurrently, exhausted %d retries", name, maxPatchRetries))
+			return
+		}
+		log.Printf("⚠️ conflict updating tenant %s, retrying (attempt %d/%d)", name, attempt, maxPatchRetries)
+	}
+
+	w.Header().Set("ETag", tenant.ResourceVersion)
+	response := TenantResponse{
+		Success: true,
+		Data: map[string]interface{}{
+			"tenant": tenant,
+			"diff":   diff,
+		},
+		Message: fmt.Sprintf("Tenant %s updated", name),
+	}
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
 // Delete tenant handler
 func deleteTenantHandler(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
@@ -251,11 +442,13 @@ func deleteTenantHandler(w http.ResponseWriter, r *http.Request) {
 	}
 
 	ctx := context.Background()
-	if err := k8sClient.Delete(ctx, tenant); err != nil {
+	cluster := tenantCluster(ctx, name)
+	if err := cluster.Client.Delete(ctx, tenant); err != nil {
 		log.Printf("❌ Failed to delete tenant %s: %v", name, err)
 		writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to delete tenant: %v", err))
 		return
 	}
+	placementIndex.Delete(name)
 
 	log.Printf("✅ Tenant %s deletion initiated", name)
 
@@ -267,28 +460,132 @@ func deleteTenantHandler(w http.ResponseWriter, r *http.Request) {
 	writeJSONResponse(w, http.StatusOK, response)
 }
 
-// List tenants handler
+// tenantWithCluster annotates a tenant with the cluster it was found on, for list
+// responses that fan out across the whole cluster registry.
+type tenantWithCluster struct {
+	tenantv1alpha1.Tenant `json:",inline"`
+	Cluster               string `json:"cluster"`
+}
+
+// List tenants handler - fans out across every registered cluster and merges results.
+// The route carries no {name} segment, so AuthzMiddleware can't scope it by path alone;
+// tenant.admin callers are restricted here to their own tenant.
 func listTenantsHandler(w http.ResponseWriter, r *http.Request) {
 	log.Printf("📋 Listing all tenants")
+	ctx := context.Background()
+
+	claims, _ := auth.ClaimsFromContext(r.Context())
+	scopedToTenant := ""
+	if claims != nil && !claims.HasRole(auth.RolePlatformAdmin) {
+		scopedToTenant = claims.TenantID
+	}
+
+	var merged []tenantWithCluster
+	for _, c := range clusterRegistry.List() {
+		var tenantList tenantv1alpha1.TenantList
+		if err := c.Client.List(ctx, &tenantList); err != nil {
+			log.Printf("❌ Failed to list tenants on cluster %s: %v", c.Name, err)
+			continue
+		}
+		for _, t := range tenantList.Items {
+			if scopedToTenant != "" && t.Name != scopedToTenant {
+				continue
+			}
+			merged = append(merged, tenantWithCluster{Tenant: t, Cluster: c.Name})
+		}
+	}
+
+	response := TenantResponse{
+		Success: true,
+		Data:    merged,
+		Message: fmt.Sprintf("Found %d tenants", len(merged)),
+	}
 
-	tenantList := &tenantv1alpha1.TenantList{}
+	writeJSONResponse(w, http.StatusOK, response)
+}
+
+// clusterInfo is the response shape for GET /api/v1/clusters.
+type clusterInfo struct {
+	Name        string `json:"name"`
+	Healthy     bool   `json:"healthy"`
+	TenantCount int    `json:"tenantCount"`
+}
+
+// List clusters handler reports every registered cluster with a basic health/capacity check.
+func listClustersHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := context.Background()
-	
-	if err := k8sClient.List(ctx, tenantList); err != nil {
-		log.Printf("❌ Failed to list tenants: %v", err)
-		writeErrorResponse(w, http.StatusInternalServerError, "Failed to list tenants")
-		return
+
+	infos := make([]clusterInfo, 0, len(clusterRegistry.List()))
+	for _, c := range clusterRegistry.List() {
+		var tenantList tenantv1alpha1.TenantList
+		err := c.Client.List(ctx, &tenantList)
+		infos = append(infos, clusterInfo{
+			Name:        c.Name,
+			Healthy:     err == nil,
+			TenantCount: len(tenantList.Items),
+		})
 	}
 
 	response := TenantResponse{
 		Success: true,
-		Data:    tenantList.Items,
-		Message: fmt.Sprintf("Found %d tenants", len(tenantList.Items)),
+		Data:    infos,
+		Message: fmt.Sprintf("Found %d registered clusters", len(infos)),
 	}
 
 	writeJSONResponse(w, http.StatusOK, response)
 }
 
+// Tenant logs handler streams merged NDJSON logs from all pods belonging to a tenant.
+func tenantLogsHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	name := vars["name"]
+	ctx := r.Context()
+
+	log.Printf("📡 Streaming logs for tenant: %s", name)
+
+	tenant := &tenantv1alpha1.Tenant{}
+	cluster := tenantCluster(ctx, name)
+	if err := cluster.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: "default"}, tenant); err != nil {
+		log.Printf("❌ Failed to get tenant %s: %v", name, err)
+		writeErrorResponse(w, http.StatusNotFound, fmt.Sprintf("Tenant %s not found", name))
+		return
+	}
+
+	namespace := fmt.Sprintf("tenant-%s", name)
+	podList, err := cluster.Clientset.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("tenant=%s", name),
+	})
+	if err != nil {
+		log.Printf("❌ Failed to list pods for tenant %s: %v", name, err)
+		writeErrorResponse(w, http.StatusInternalServerError, fmt.Sprintf("Failed to list pods: %v", err))
+		return
+	}
+
+	opts := logs.Options{
+		Service:   r.URL.Query().Get("service"),
+		Container: r.URL.Query().Get("container"),
+	}
+	if tail := r.URL.Query().Get("tail"); tail != "" {
+		if n, err := strconv.ParseInt(tail, 10, 64); err == nil {
+			opts.TailLines = n
+		}
+	}
+	if since := r.URL.Query().Get("since"); since != "" {
+		if d, err := time.ParseDuration(since); err == nil {
+			opts.Since = d
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/x-ndjson")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+
+	streamer := logs.NewStreamer(cluster.Clientset)
+	if err := streamer.Stream(ctx, w, namespace, podList.Items, opts); err != nil {
+		log.Printf("⚠️ Log stream for tenant %s ended: %v", name, err)
+	}
+}
+
 // Helper functions
 func convertServices(services []ServiceSpec) []tenantv1alpha1.ServiceSpec {
 	result := make([]tenantv1alpha1.ServiceSpec, len(services))
@@ -320,7 +617,9 @@ func corsMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Access-Control-Allow-Origin", "*")
 		w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization, X-User-Role, X-Tenant-ID")
+		// X-User-Role/X-Tenant-ID are no longer trusted for authorization decisions; the
+		// Verifier.Middleware/AuthzMiddleware pair derives identity from the bearer token instead.
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
 		
 		if r.Method == "OPTIONS" {
 			w.WriteHeader(http.StatusOK)
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/gorilla/mux"
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/clusters"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// conflictOnceClient wraps a client.Client and fails the first n Update calls with a
+// Conflict error, simulating another writer racing the same resourceVersion, so tests can
+// drive patchTenantHandler's maxPatchRetries retry loop deterministically.
+type conflictOnceClient struct {
+	client.Client
+	mu        sync.Mutex
+	conflicts int
+}
+
+func (c *conflictOnceClient) Update(ctx context.Context, obj client.Object, opts ...client.UpdateOption) error {
+	c.mu.Lock()
+	if c.conflicts > 0 {
+		c.conflicts--
+		c.mu.Unlock()
+		return apierrors.NewConflict(schema.GroupResource{Group: "tenant.rezenkai.com", Resource: "tenants"}, obj.GetName(), fmt.Errorf("concurrent update"))
+	}
+	c.mu.Unlock()
+	return c.Client.Update(ctx, obj, opts...)
+}
+
+func newPatchTestTenant() *tenantv1alpha1.Tenant {
+	return &tenantv1alpha1.Tenant{
+		ObjectMeta: metav1.ObjectMeta{Name: "acme", Namespace: "default", ResourceVersion: "1"},
+		Spec: tenantv1alpha1.TenantSpec{
+			OrganizationName: "Acme Corp",
+			Tier:             "standard",
+		},
+	}
+}
+
+// withPatchTestRegistry swaps the package-level clusterRegistry/placementIndex for the
+// duration of a test, registering a single "default" cluster backed by c.
+func withPatchTestRegistry(t *testing.T, c client.Client) {
+	t.Helper()
+	prevRegistry, prevIndex := clusterRegistry, placementIndex
+	t.Cleanup(func() { clusterRegistry, placementIndex = prevRegistry, prevIndex })
+
+	clusterRegistry = clusters.NewRegistry(scheme)
+	clusterRegistry.Add("default", c, nil)
+	placementIndex = clusters.NewPlacementIndex()
+}
+
+func doPatchRequest(body string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPatch, "/api/v1/tenants/acme", bytes.NewBufferString(body))
+	req = mux.SetURLVars(req, map[string]string{"name": "acme"})
+	rec := httptest.NewRecorder()
+	patchTenantHandler(rec, req)
+	return rec
+}
+
+func TestPatchTenantHandler_RetriesOnConflictThenSucceeds(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPatchTestTenant()).Build()
+	wrapped := &conflictOnceClient{Client: fakeClient, conflicts: 2}
+	withPatchTestRegistry(t, wrapped)
+
+	rec := doPatchRequest(`{"tier":"premium"}`)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+	if wrapped.conflicts != 0 {
+		t.Errorf("expected all conflicts to be consumed by retries, %d left", wrapped.conflicts)
+	}
+
+	var updated tenantv1alpha1.Tenant
+	if err := wrapped.Get(context.Background(), client.ObjectKey{Name: "acme", Namespace: "default"}, &updated); err != nil {
+		t.Fatalf("failed to re-fetch tenant: %v", err)
+	}
+	if updated.Spec.Tier != "premium" {
+		t.Errorf("expected tier to be updated to %q, got %q", "premium", updated.Spec.Tier)
+	}
+}
+
+func TestPatchTenantHandler_ExhaustsRetriesOnPersistentConflict(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPatchTestTenant()).Build()
+	wrapped := &conflictOnceClient{Client: fakeClient, conflicts: maxPatchRetries + 1}
+	withPatchTestRegistry(t, wrapped)
+
+	rec := doPatchRequest(`{"tier":"premium"}`)
+
+	if rec.Code != http.StatusConflict {
+		t.Fatalf("expected 409 after exhausting retries, got %d: %s", rec.Code, rec.Body.String())
+	}
+}
+
+func TestPatchTenantHandler_ImmutableFieldRejected(t *testing.T) {
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newPatchTestTenant()).Build()
+	withPatchTestRegistry(t, fakeClient)
+
+	rec := doPatchRequest(`{"organizationName":"Evil Corp"}`)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected 200, got %d: %s", rec.Code, rec.Body.String())
+	}
+
+	var updated tenantv1alpha1.Tenant
+	if err := fakeClient.Get(context.Background(), client.ObjectKey{Name: "acme", Namespace: "default"}, &updated); err != nil {
+		t.Fatalf("failed to re-fetch tenant: %v", err)
+	}
+	if updated.Spec.OrganizationName != "Acme Corp" {
+		t.Errorf("expected organizationName to remain unchanged, got %q", updated.Spec.OrganizationName)
+	}
+}
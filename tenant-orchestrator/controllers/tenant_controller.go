@@ -6,8 +6,13 @@ import (
 	"time"
 
 	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/backoff"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/credentials"
 	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/discovery"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/events"
 	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/health"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/job"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/provisioner"
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -17,29 +22,88 @@ import (
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 )
 
 const (
 	tenantFinalizer = "tenant.grg-automation.com/finalizer"
 	ownerKey        = ".metadata.controller"
 	apiVersion      = "tenant.grg-automation.com/v1alpha1"
-	authServiceURL  = "http://localhost:3002/api/v1/tenants/ready"
 )
 
+// fieldOwner identifies this controller's field manager on server-side-applied objects.
+const fieldOwner = client.FieldOwner("tenant-orchestrator")
+
+// steadyStateRequeue is how often a healthy tenant is re-reconciled absent any other
+// trigger. Failing tenants instead back off per Backoff, so a flapping tenant can't crowd
+// out this steady cadence for everyone else.
+const steadyStateRequeue = 5 * time.Minute
+
+// backgroundSyncInterval is how often the job.Scheduler re-runs the drift syncs against
+// every Tenant, independent of the event-driven reconciler.
+const backgroundSyncInterval = 10 * time.Minute
+
+// childTenantLabel is stamped on every same-namespace child object (Deployment, Service,
+// Ingress, CronJob, ...) and is how mapChildToTenant maps a watched child back to its Tenant.
+const childTenantLabel = "tenant.rezenkai.com/name"
+
 // TenantReconciler reconciles a Tenant object
 type TenantReconciler struct {
 	client.Client
-	Scheme        *runtime.Scheme
-	Discovery     *discovery.Client
-	HealthMonitor *health.Monitor
-	EventRecorder record.EventRecorder
+	Scheme                *runtime.Scheme
+	Discovery             *discovery.Client
+	HealthMonitor         *health.Monitor
+	CredentialRotator     *credentials.Rotator
+	ProvisionerSet        provisioner.Set
+	EventRecorder         record.EventRecorder
+	Events                *events.Recorder
+	Backoff               *backoff.Tracker
+	// EnableOwnerReferences makes every same-namespace child object (database StatefulSet,
+	// Secret, Services) controller-owned by its Tenant, so deleting the Tenant cascades via
+	// Kubernetes garbage collection. When false, cleanupTenantResources is solely
+	// responsible for cleanup, matching pre-SSA behavior.
+	EnableOwnerReferences bool
+}
+
+// apply server-side-applies obj so a later change to the owning Tenant (e.g.
+// Spec.Database.Version or Spec.Resources.CPU.Limit) propagates to it, instead of being
+// silently ignored the way Create-if-not-exists was.
+func (r *TenantReconciler) apply(ctx context.Context, obj client.Object) error {
+	gvks, _, err := r.Scheme.ObjectKinds(obj)
+	if err != nil || len(gvks) == 0 {
+		return fmt.Errorf("failed to look up GroupVersionKind for %T: %w", obj, err)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+	return r.Patch(ctx, obj, client.Apply, fieldOwner, client.ForceOwnership)
+}
+
+// setOwnerReference makes tenant the controller owner of obj when EnableOwnerReferences is
+// set.
+func (r *TenantReconciler) setOwnerReference(tenant *tenantv1alpha1.Tenant, obj client.Object) error {
+	if !r.EnableOwnerReferences {
+		return nil
+	}
+	return controllerutil.SetControllerReference(tenant, obj, r.Scheme)
+}
+
+// recordTransition durably records a tenant lifecycle transition through the events
+// outbox, replacing the ad-hoc r.EventRecorder.Event calls this reconciler used to make
+// directly; a Worker elsewhere fans it out to every configured Sink, one of which is a
+// Kubernetes Event so `kubectl describe tenant` keeps working unchanged.
+func (r *TenantReconciler) recordTransition(ctx context.Context, tenant *tenantv1alpha1.Tenant, transition events.Transition, message string) error {
+	if r.Events == nil {
+		return nil
+	}
+	return r.Events.Record(ctx, tenant.Name, transition, message)
 }
 
 // Reconcile the Tenant resource with improved error handling
@@ -85,8 +149,10 @@ func (r *TenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// Perform tenant reconciliation
 	result, err := r.reconcileTenant(ctx, &tenant)
 	if err != nil {
-		r.EventRecorder.Event(&tenant, corev1.EventTypeWarning, "ReconcileError", err.Error())
-		
+		if recErr := r.recordTransition(ctx, &tenant, events.Failed, err.Error()); recErr != nil {
+			log.Error(recErr, "failed to record Failed transition")
+		}
+
 		// Update status to reflect error
 		tenant.Status.Phase = "Failed"
 		meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
@@ -117,12 +183,16 @@ func (r *TenantReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 func (r *TenantReconciler) updateStatusWithRetry(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
 	log := log.FromContext(ctx)
 	
+	backoffKey := tenant.Name + "/status"
+	defer r.Backoff.Forget(backoffKey)
+
 	for i := 0; i < 3; i++ {
 		if err := r.Status().Update(ctx, tenant); err != nil {
 			if errors.IsConflict(err) {
-				log.Info("conflict during status update, retrying", "attempt", i+1)
-				time.Sleep(time.Duration(i+1) * time.Second)
-				
+				delay := r.Backoff.Next(backoffKey)
+				log.Info("conflict during status update, retrying", "attempt", i+1, "delay", delay)
+				time.Sleep(delay)
+
 				// Re-fetch the latest version
 				if fetchErr := r.Get(ctx, client.ObjectKeyFromObject(tenant), tenant); fetchErr != nil {
 					return fetchErr
@@ -136,18 +206,18 @@ func (r *TenantReconciler) updateStatusWithRetry(ctx context.Context, tenant *te
 	return fmt.Errorf("failed to update status after 3 attempts")
 }
 
+// reconcileBackup schedules periodic WAL-G backup pushes to the tenant's object storage
+// bucket. Patroni/Spilo already continuously archives WAL segments via wal-g when
+// USE_WALG_BACKUP is set (see provisioner.KubernetesProvisioner); this CronJob additionally triggers a
+// full base backup on the configured schedule.
 func (r *TenantReconciler) reconcileBackup(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
 	log := log.FromContext(ctx).WithValues("tenant", tenant.Name)
-	
-	// TODO: Implement backup job creation
-	// For now, just log that backup is being set up
-	log.Info("Setting up backup configuration", "tenant", tenant.Name)
-	
-	// Create a simple CronJob for database backup (placeholder)
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+
 	cronJob := &batchv1.CronJob{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      fmt.Sprintf("%s-db-backup", tenant.Name),
-			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+			Namespace: namespace,
 			Labels: map[string]string{
 				"tenant.rezenkai.com/name": tenant.Name,
 				"app.kubernetes.io/managed-by": "tenant-orchestrator",
@@ -160,14 +230,22 @@ func (r *TenantReconciler) reconcileBackup(ctx context.Context, tenant *tenantv1
 				Spec: batchv1.JobSpec{
 					Template: corev1.PodTemplateSpec{
 						Spec: corev1.PodSpec{
-							RestartPolicy: corev1.RestartPolicyOnFailure,
+							ServiceAccountName: fmt.Sprintf("%s-patroni", tenant.Name),
+							RestartPolicy:      corev1.RestartPolicyOnFailure,
 							Containers: []corev1.Container{
 								{
-									Name:  "backup",
-									Image: "postgres:15", // Use same version as database
+									Name:  "wal-g-backup-push",
+									Image: fmt.Sprintf("ghcr.io/zalando/spilo-%s:3.2-p1", tenant.Spec.Database.Version),
 									Command: []string{
 										"sh", "-c",
-										"echo 'Backup placeholder - implement pg_dump here'",
+										"wal-g backup-push $PGDATA",
+									},
+									Env: []corev1.EnvVar{
+										{Name: "WAL_S3_BUCKET", Value: tenant.Spec.Database.Backup.WALS3Bucket},
+										{Name: "PGDATA", Value: "/home/postgres/pgdata/pgroot/data"},
+										{Name: "PGHOST", Value: fmt.Sprintf("%s-db-primary", tenant.Name)},
+										{Name: "PGUSER", ValueFrom: provisioner.SecretKeyRef(tenant, "username")},
+										{Name: "PGPASSWORD", ValueFrom: provisioner.SecretKeyRef(tenant, "password")},
 									},
 								},
 							},
@@ -177,35 +255,106 @@ func (r *TenantReconciler) reconcileBackup(ctx context.Context, tenant *tenantv1
 			},
 		},
 	}
-	
-	if err := r.Create(ctx, cronJob); err != nil && !errors.IsAlreadyExists(err) {
-		log.Error(err, "Failed to create backup CronJob")
+
+	if err := r.setOwnerReference(tenant, cronJob); err != nil {
+		return err
+	}
+	if err := r.apply(ctx, cronJob); err != nil {
+		log.Error(err, "Failed to apply backup CronJob")
+		return err
+	}
+
+	if tenant.Spec.Database.Restore != nil && tenant.Spec.Database.Restore.Enabled {
+		if err := r.reconcileRestore(ctx, tenant); err != nil {
+			log.Error(err, "Failed to create PITR restore Job")
+			return err
+		}
+	}
+
+	log.Info("Backup CronJob reconciled", "bucket", tenant.Spec.Database.Backup.WALS3Bucket)
+	return nil
+}
+
+// reconcileRestore creates a point-in-time-recovery Job that restores a fresh base backup
+// from Spec.Database.Restore.SourceCluster via wal-g and replays WAL up to TargetTime. It
+// is gated behind the Restore stanza so a normal reconcile never re-triggers a restore.
+func (r *TenantReconciler) reconcileRestore(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+	restore := tenant.Spec.Database.Restore
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-db-restore", tenant.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name": tenant.Name,
+				"app.kubernetes.io/managed-by": "tenant-orchestrator",
+				"app.kubernetes.io/component": "restore",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					ServiceAccountName: fmt.Sprintf("%s-patroni", tenant.Name),
+					RestartPolicy:      corev1.RestartPolicyNever,
+					Containers: []corev1.Container{
+						{
+							Name:  "wal-g-backup-fetch",
+							Image: fmt.Sprintf("ghcr.io/zalando/spilo-%s:3.2-p1", tenant.Spec.Database.Version),
+							Command: []string{
+								"sh", "-c",
+								"wal-g backup-fetch $PGDATA LATEST && wal-g wal-fetch $WALG_RESTORE_TARGET_TIME $PGDATA",
+							},
+							Env: []corev1.EnvVar{
+								{Name: "WAL_S3_BUCKET", Value: restore.SourceCluster},
+								{Name: "WALG_RESTORE_TARGET_TIME", Value: restore.TargetTime},
+								{Name: "PGDATA", Value: "/home/postgres/pgdata/pgroot/data"},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
 		return err
 	}
-	
-	log.Info("Backup CronJob created successfully")
 	return nil
 }
 
+// notifyTenantReady records the tenant's transition to Active through the events outbox,
+// so billing, monitoring, and any other consumer can fan out from there instead of this
+// reconciler posting to each of them directly.
 func (r *TenantReconciler) notifyTenantReady(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
 	log := log.FromContext(ctx).WithValues("tenant", tenant.Name)
-	
-	// TODO: Implement notification to external services
-	// For now, just log that tenant is ready
-	log.Info("Tenant is ready and active", 
-		"tenant", tenant.Name, 
+
+	log.Info("Tenant is ready and active",
+		"tenant", tenant.Name,
 		"organization", tenant.Spec.OrganizationName,
 		"url", tenant.Status.URL,
 	)
-	
-	// You could add HTTP calls to notify other services here
-	// Example:
-	// - Notify billing service
-	// - Notify monitoring service  
-	// - Send email to tenant admin
-	// - Update external registry
-	
-	return nil
+
+	return r.recordTransition(ctx, tenant, events.Active, "Tenant is active and healthy")
+}
+
+// reconcileDatabase provisions tenant's database on first reconcile and, on every later
+// one, diffs Spec.Database.Version against the version Status last recorded. A version
+// change calls Provisioner.Update against the existing ProvisionID instead of Provision,
+// since Provision is only idempotent for unchanged tenants and several implementations
+// (e.g. ExternalRDSProvisioner) never look at Spec.Database.Version once the backend
+// already exists.
+func (r *TenantReconciler) reconcileDatabase(ctx context.Context, dbProvisioner provisioner.Provisioner, tenant *tenantv1alpha1.Tenant) (string, error) {
+	desiredVersion := tenant.Spec.Database.Version
+
+	if tenant.Status.ProvisionID != "" && tenant.Status.DatabaseStatus.Version != "" && tenant.Status.DatabaseStatus.Version != desiredVersion {
+		if err := dbProvisioner.Update(ctx, tenant.Status.ProvisionID, desiredVersion); err != nil {
+			return "", fmt.Errorf("failed to update database to version %s: %w", desiredVersion, err)
+		}
+		return tenant.Status.ProvisionID, nil
+	}
+
+	return dbProvisioner.Provision(ctx, tenant)
 }
 
 // Simplified reconcileTenant
@@ -215,7 +364,9 @@ func (r *TenantReconciler) reconcileTenant(ctx context.Context, tenant *tenantv1
 
 	if tenant.Status.Phase == "" || tenant.Status.Phase == "Pending" {
 		tenant.Status.Phase = "Provisioning"
-		r.EventRecorder.Event(tenant, corev1.EventTypeNormal, "Provisioning", "Starting tenant provisioning")
+		if err := r.recordTransition(ctx, tenant, events.Provisioning, "Starting tenant provisioning"); err != nil {
+			log.Error(err, "failed to record Provisioning transition")
+		}
 	}
 
 	if err := r.ensureNamespace(ctx, tenant); err != nil {
@@ -223,20 +374,48 @@ func (r *TenantReconciler) reconcileTenant(ctx context.Context, tenant *tenantv1
 		return ctrl.Result{}, err
 	}
 
-	if err := r.reconcileDatabase(ctx, tenant); err != nil {
+	dbProvisioner, err := r.ProvisionerSet.Get(tenant.Spec.Provisioner)
+	if err != nil {
+		meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+			Type:    "DatabaseReady",
+			Status:  metav1.ConditionFalse, // This resolves to "False"
+			Reason:  "DatabaseError",
+			Message: err.Error(),
+		})
+		return ctrl.Result{}, err
+	}
+
+	provisionID, err := r.reconcileDatabase(ctx, dbProvisioner, tenant)
+	if err != nil {
 		meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
 			Type:    "DatabaseReady",
 			Status:  metav1.ConditionFalse, // This resolves to "False"
 			Reason:  "DatabaseError",
 			Message: err.Error(),
 		})
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+		return ctrl.Result{RequeueAfter: r.Backoff.Next(tenant.Name)}, err
+	}
+	tenant.Status.ProvisionID = provisionID
+	tenant.Status.DatabaseStatus.Version = tenant.Spec.Database.Version
+	meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:    "DatabaseReady",
+		Status:  metav1.ConditionTrue, // This resolves to "True"
+		Reason:  "DatabaseProvisioned",
+		Message: "Database is provisioned and ready",
+	})
+
+	if r.CredentialRotator != nil && r.CredentialRotator.Due(tenant) {
+		if err := r.CredentialRotator.Rotate(ctx, tenant); err != nil {
+			log.Error(err, "Failed to rotate database credentials")
+			// Don't fail reconciliation; the old credentials are still valid and the
+			// next reconcile will retry the rotation.
+		}
 	}
 
 	for _, svc := range tenant.Spec.Services {
 		if err := r.reconcileService(ctx, tenant, svc); err != nil {
 			log.Error(err, "Failed to reconcile service", "service", svc.Name)
-			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+			return ctrl.Result{RequeueAfter: r.Backoff.Next(tenant.Name)}, err
 		}
 	}
 
@@ -248,7 +427,10 @@ func (r *TenantReconciler) reconcileTenant(ctx context.Context, tenant *tenantv1
 				Reason:  "BackupError",
 				Message: err.Error(),
 			})
-			return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+			if recErr := r.recordTransition(ctx, tenant, events.BackupFailed, err.Error()); recErr != nil {
+				log.Error(recErr, "failed to record BackupFailed transition")
+			}
+			return ctrl.Result{RequeueAfter: r.Backoff.Next(tenant.Name)}, err
 		}
 		meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
 			Type:    "BackupReady",
@@ -256,12 +438,15 @@ func (r *TenantReconciler) reconcileTenant(ctx context.Context, tenant *tenantv1
 			Reason:  "BackupProvisioned",
 			Message: "Backups are configured",
 		})
+		if err := r.recordTransition(ctx, tenant, events.BackupSucceeded, "Backups are configured"); err != nil {
+			log.Error(err, "failed to record BackupSucceeded transition")
+		}
 	}
 
 	tenant.Status.URL = fmt.Sprintf("https://mysite/%s/api", tenantId)
 	if err := r.reconcileIngress(ctx, tenant); err != nil {
 		log.Error(err, "Failed to reconcile ingress")
-		return ctrl.Result{RequeueAfter: 30 * time.Second}, err
+		return ctrl.Result{RequeueAfter: r.Backoff.Next(tenant.Name)}, err
 	}
 
 	// Check health but don't fail if database isn't ready yet
@@ -279,7 +464,6 @@ func (r *TenantReconciler) reconcileTenant(ctx context.Context, tenant *tenantv1
 			Reason:  "TenantActive",
 			Message: "Tenant is active and healthy",
 		})
-		r.EventRecorder.Event(tenant, corev1.EventTypeNormal, "Active", "Tenant is active and healthy")
 		if err := r.notifyTenantReady(ctx, tenant); err != nil {
 			log.Error(err, "Failed to notify tenant readiness")
 		}
@@ -298,7 +482,8 @@ func (r *TenantReconciler) reconcileTenant(ctx context.Context, tenant *tenantv1
 		// Don't fail the reconciliation for discovery errors
 	}
 
-	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
+	r.Backoff.Forget(tenant.Name)
+	return ctrl.Result{RequeueAfter: steadyStateRequeue}, nil
 }
 
 // Simplified ensureNamespace
@@ -315,7 +500,7 @@ func (r *TenantReconciler) ensureNamespace(ctx context.Context, tenant *tenantv1
 		},
 	}
 
-	if err := r.Create(ctx, ns); err != nil && !errors.IsAlreadyExists(err) {
+	if err := r.apply(ctx, ns); err != nil {
 		return err
 	}
 
@@ -334,7 +519,7 @@ func (r *TenantReconciler) ensureNamespace(ctx context.Context, tenant *tenantv1
 		},
 	}
 
-	if err := r.Create(ctx, quota); err != nil && !errors.IsAlreadyExists(err) {
+	if err := r.apply(ctx, quota); err != nil {
 		return err
 	}
 
@@ -346,7 +531,9 @@ func (r *TenantReconciler) handleDeletion(ctx context.Context, tenant *tenantv1a
 	log := log.FromContext(ctx)
 	if controllerutil.ContainsFinalizer(tenant, tenantFinalizer) {
 		tenant.Status.Phase = "Terminating"
-		r.EventRecorder.Event(tenant, corev1.EventTypeNormal, "Terminating", "Starting tenant termination")
+		if err := r.recordTransition(ctx, tenant, events.Terminating, "Starting tenant termination"); err != nil {
+			log.Error(err, "failed to record Terminating transition")
+		}
 
 		if err := r.cleanupTenantResources(ctx, tenant); err != nil {
 			log.Error(err, "Failed to clean up tenant resources")
@@ -363,6 +550,16 @@ func (r *TenantReconciler) handleDeletion(ctx context.Context, tenant *tenantv1a
 
 // Clean up tenant resources
 func (r *TenantReconciler) cleanupTenantResources(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	log := log.FromContext(ctx).WithValues("tenant", tenant.Name)
+
+	if tenant.Status.ProvisionID != "" {
+		if dbProvisioner, err := r.ProvisionerSet.Get(tenant.Spec.Provisioner); err != nil {
+			log.Error(err, "No provisioner registered to deprovision tenant database, continuing with namespace deletion")
+		} else if err := dbProvisioner.Deprovision(ctx, tenant.Status.ProvisionID); err != nil {
+			return err
+		}
+	}
+
 	// Delete the tenant namespace (this will delete all resources in it)
 	ns := &corev1.Namespace{
 		ObjectMeta: metav1.ObjectMeta{
@@ -378,102 +575,113 @@ func (r *TenantReconciler) cleanupTenantResources(ctx context.Context, tenant *t
 }
 
 func (r *TenantReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	scheduler := job.NewScheduler(r.Client, backgroundSyncInterval)
+	scheduler.Register("health-drift", r.reconcileHealthDrift)
+	scheduler.Register("backup-drift", r.reconcileBackupDrift)
+	scheduler.Register("discovery-drift", r.reconcileDiscoveryDrift)
+	if err := mgr.Add(scheduler); err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&tenantv1alpha1.Tenant{}).
 		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Watches(&appsv1.Deployment{}, handler.EnqueueRequestsFromMapFunc(r.mapChildToTenant)).
+		Watches(&appsv1.StatefulSet{}, handler.EnqueueRequestsFromMapFunc(r.mapChildToTenant)).
+		Watches(&networkingv1.Ingress{}, handler.EnqueueRequestsFromMapFunc(r.mapChildToTenant)).
 		Complete(r)
 }
 
-func (r *TenantReconciler) reconcileDatabase(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
-	// Create database service first
-	dbService := &corev1.Service{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-db-svc", tenant.Name),
-			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
-			Labels: map[string]string{
-				"tenant.rezenkai.com/name": tenant.Name,
-				"app.kubernetes.io/managed-by": "tenant-orchestrator",
-				"app.kubernetes.io/part-of": "tenant-infrastructure",
-				"app.kubernetes.io/component": "database",
-			},
-		},
-		Spec: corev1.ServiceSpec{
-			Selector: map[string]string{"app": "postgres", "tenant": tenant.Name},
-			Ports: []corev1.ServicePort{
-				{
-					Port:       5432,
-					TargetPort: intstr.FromInt(5432),
-					Protocol:   corev1.ProtocolTCP,
-				},
-			},
-		},
+// mapChildToTenant maps a watched child object back to the Tenant reconcile request for its
+// childTenantLabel value, so a child being deleted or edited out-of-band triggers an
+// immediate reconcile instead of waiting for the next steady-state requeue.
+func (r *TenantReconciler) mapChildToTenant(ctx context.Context, obj client.Object) []reconcile.Request {
+	name, ok := obj.GetLabels()[childTenantLabel]
+	if !ok {
+		return nil
 	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Name: name, Namespace: "default"}}}
+}
 
-	if err := r.Create(ctx, dbService); err != nil && !errors.IsAlreadyExists(err) {
-		return err
+// reconcileHealthDrift re-checks a tenant's child resources via HealthMonitor on the
+// background sync cadence, so health status doesn't go stale between spec changes.
+func (r *TenantReconciler) reconcileHealthDrift(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	healthy, err := r.HealthMonitor.CheckTenantHealth(ctx, tenant)
+	if err != nil {
+		return fmt.Errorf("failed to check tenant health: %w", err)
 	}
 
-	secret := &corev1.Secret{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-db-credentials", tenant.Name),
-			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
-			Labels: map[string]string{
-				"tenant.rezenkai.com/name": tenant.Name,
-				"app.kubernetes.io/managed-by": "tenant-orchestrator",
-				"app.kubernetes.io/part-of": "tenant-infrastructure",
-			},
-		},
-		Type: corev1.SecretTypeOpaque,
-		Data: map[string][]byte{
-			"username": []byte(fmt.Sprintf("tenant_%s", tenant.Name)),
-			"password": []byte("SecurePassword123!"),
-			"database": []byte(fmt.Sprintf("tenant_%s_db", tenant.Name)),
-		},
+	status, reason, message := metav1.ConditionFalse, "TenantNotReady", "Tenant services are not ready yet"
+	if healthy {
+		status, reason, message = metav1.ConditionTrue, "TenantActive", "Tenant is active and healthy"
 	}
+	meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+		Type:    "Ready",
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+	return r.updateStatusWithRetry(ctx, tenant)
+}
 
-	if err := r.Create(ctx, secret); err != nil && !errors.IsAlreadyExists(err) {
-		return err
+// reconcileBackupDrift ensures the tenant's backup CronJob still exists and that its last
+// scheduled run succeeded, since a CronJob deleted out-of-band or a silently failing backup
+// would otherwise go unnoticed until the next spec change.
+func (r *TenantReconciler) reconcileBackupDrift(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	if !tenant.Spec.Database.Backup.Enabled {
+		return nil
 	}
 
-	statefulSet := r.databaseStatefulSet(tenant)
-	if err := r.Create(ctx, statefulSet); err != nil && !errors.IsAlreadyExists(err) {
+	if err := r.reconcileBackup(ctx, tenant); err != nil {
+		if recErr := r.recordTransition(ctx, tenant, events.BackupFailed, err.Error()); recErr != nil {
+			return recErr
+		}
 		return err
 	}
 
-	tenant.Status.DatabaseStatus.ConnectionURL = fmt.Sprintf("%s-db-svc.tenant-%s.svc.cluster.local:5432/%s", tenant.Name, tenant.Name, fmt.Sprintf("tenant_%s_db", tenant.Name))
-	
-	// FIXED: Use the actual Kubernetes constants that resolve to proper capitalized strings
-	meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
-		Type:    "DatabaseReady",
-		Status:  metav1.ConditionTrue, // This resolves to "True"
-		Reason:  "DatabaseProvisioned",
-		Message: "Database is provisioned and ready",
-	})
+	var cronJob batchv1.CronJob
+	key := types.NamespacedName{
+		Name:      fmt.Sprintf("%s-db-backup", tenant.Name),
+		Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
+	}
+	if err := r.Get(ctx, key, &cronJob); err != nil {
+		return fmt.Errorf("failed to read backup CronJob: %w", err)
+	}
+
+	if cronJob.Status.LastScheduleTime != nil &&
+		(cronJob.Status.LastSuccessfulTime == nil || cronJob.Status.LastSuccessfulTime.Before(cronJob.Status.LastScheduleTime)) {
+		return r.recordTransition(ctx, tenant, events.BackupFailed, "Last scheduled backup run has not completed successfully")
+	}
 	return nil
 }
 
+// reconcileDiscoveryDrift re-pushes a tenant's service endpoints on every background sync
+// tick regardless of whether anything changed, so discovery state self-heals if an update
+// was ever missed or overwritten between event-driven reconciles.
+func (r *TenantReconciler) reconcileDiscoveryDrift(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	return r.Discovery.UpdateServiceEndpoints(ctx, tenant)
+}
+
 // Updated reconcileService method
 func (r *TenantReconciler) reconcileService(ctx context.Context, tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec) error {
     log := log.FromContext(ctx)
     deployment := r.serviceDeployment(tenant, svc)
-    // Remove cross-namespace owner reference
-    // if err := controllerutil.SetControllerReference(tenant, deployment, r.Scheme); err != nil {
-    //     log.Error(err, "Failed to set controller reference for deployment", "service", svc.Name)
-    //     return err
-    // }
-    if err := r.Create(ctx, deployment); err != nil && !errors.IsAlreadyExists(err) {
-        log.Error(err, "Failed to create deployment", "service", svc.Name)
+    if err := r.setOwnerReference(tenant, deployment); err != nil {
+        log.Error(err, "Failed to set controller reference for deployment", "service", svc.Name)
+        return err
+    }
+    if err := r.apply(ctx, deployment); err != nil {
+        log.Error(err, "Failed to apply deployment", "service", svc.Name)
         return err
     }
-    
+
     service := r.kubernetesService(tenant, svc)
-    // Remove cross-namespace owner reference
-    // if err := controllerutil.SetControllerReference(tenant, service, r.Scheme); err != nil {
-    //     log.Error(err, "Failed to set controller reference for service", "service", svc.Name)
-    //     return err
-    // }
-    if err := r.Create(ctx, service); err != nil && !errors.IsAlreadyExists(err) {
-        log.Error(err, "Failed to create service", "service", svc.Name)
+    if err := r.setOwnerReference(tenant, service); err != nil {
+        log.Error(err, "Failed to set controller reference for service", "service", svc.Name)
+        return err
+    }
+    if err := r.apply(ctx, service); err != nil {
+        log.Error(err, "Failed to apply service", "service", svc.Name)
         return err
     }
     log.Info("Successfully reconciled service", "service", svc.Name)
@@ -523,97 +731,12 @@ func (r *TenantReconciler) reconcileIngress(ctx context.Context, tenant *tenantv
 	}
 	
 	// Не устанавливаем owner reference для cross-namespace ресурсов
-	if err := r.Create(ctx, ingress); err != nil && !errors.IsAlreadyExists(err) {
+	if err := r.apply(ctx, ingress); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Updated helper methods with proper labels
-func (r *TenantReconciler) databaseStatefulSet(tenant *tenantv1alpha1.Tenant) *appsv1.StatefulSet {
-	replicas := int32(1)
-	labels := map[string]string{
-		"app":    "postgres",
-		"tenant": tenant.Name,
-		"tenant.rezenkai.com/name": tenant.Name,
-		"app.kubernetes.io/managed-by": "tenant-orchestrator",
-		"app.kubernetes.io/part-of": "tenant-infrastructure",
-		"app.kubernetes.io/component": "database",
-	}
-	
-	return &appsv1.StatefulSet{
-		ObjectMeta: metav1.ObjectMeta{
-			Name:      fmt.Sprintf("%s-db", tenant.Name),
-			Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
-			Labels:    labels,
-		},
-		Spec: appsv1.StatefulSetSpec{
-			Replicas:    &replicas,
-			ServiceName: fmt.Sprintf("%s-db-svc", tenant.Name),
-			Selector: &metav1.LabelSelector{
-				MatchLabels: map[string]string{
-					"app": "postgres", 
-					"tenant": tenant.Name,
-				},
-			},
-			Template: corev1.PodTemplateSpec{
-				ObjectMeta: metav1.ObjectMeta{Labels: labels},
-				Spec: corev1.PodSpec{
-					Containers: []corev1.Container{
-						{
-							Name:  "postgres",
-							Image: fmt.Sprintf("postgres:%s", tenant.Spec.Database.Version),
-							Env: []corev1.EnvVar{
-								{Name: "POSTGRES_DB", Value: fmt.Sprintf("tenant_%s_db", tenant.Name)},
-								{Name: "POSTGRES_USER", Value: fmt.Sprintf("tenant_%s", tenant.Name)},
-								{Name: "POSTGRES_PASSWORD", Value: "SecurePassword123!"},
-								{Name: "PGDATA", Value: "/var/lib/postgresql/data/pgdata"},
-							},
-							Ports: []corev1.ContainerPort{
-								{ContainerPort: 5432, Name: "postgres"},
-							},
-							VolumeMounts: []corev1.VolumeMount{
-								{
-									Name:      "postgres-storage",
-									MountPath: "/var/lib/postgresql/data",
-								},
-							},
-							Resources: corev1.ResourceRequirements{
-								Requests: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("100m"),
-									corev1.ResourceMemory: resource.MustParse("128Mi"),
-								},
-								Limits: corev1.ResourceList{
-									corev1.ResourceCPU:    resource.MustParse("500m"),
-									corev1.ResourceMemory: resource.MustParse("512Mi"),
-								},
-							},
-						},
-					},
-				},
-			},
-			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
-				{
-					ObjectMeta: metav1.ObjectMeta{
-						Name: "postgres-storage",
-					},
-					Spec: corev1.PersistentVolumeClaimSpec{
-						AccessModes: []corev1.PersistentVolumeAccessMode{
-							corev1.ReadWriteOnce,
-						},
-						// FIXED: Use VolumeResourceRequirements instead of ResourceRequirements
-						Resources: corev1.VolumeResourceRequirements{
-							Requests: corev1.ResourceList{
-								corev1.ResourceStorage: resource.MustParse(tenant.Spec.Resources.Storage.Size),
-							},
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
 // Updated service deployment with proper labels
 // Updated service deployment with proper resource specifications
 func (r *TenantReconciler) serviceDeployment(tenant *tenantv1alpha1.Tenant, svc tenantv1alpha1.ServiceSpec) *appsv1.Deployment {
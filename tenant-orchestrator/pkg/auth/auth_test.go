@@ -0,0 +1,150 @@
+package auth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const testSecret = "test-signing-secret"
+
+func signToken(t *testing.T, claims Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
+	signed, err := token.SignedString([]byte(testSecret))
+	if err != nil {
+		t.Fatalf("failed to sign test token: %v", err)
+	}
+	return signed
+}
+
+func TestVerifierParse_Valid(t *testing.T) {
+	v := NewVerifier([]byte(testSecret), nil)
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles:    []string{RoleTenantAdmin},
+		TenantID: "acme",
+	}
+
+	got, err := v.Parse(signToken(t, claims))
+	if err != nil {
+		t.Fatalf("expected valid token, got error: %v", err)
+	}
+	if got.TenantID != "acme" {
+		t.Errorf("expected tenant_id acme, got %q", got.TenantID)
+	}
+}
+
+func TestVerifierParse_Expired(t *testing.T) {
+	v := NewVerifier([]byte(testSecret), nil)
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+		},
+		Roles:    []string{RoleTenantAdmin},
+		TenantID: "acme",
+	}
+
+	if _, err := v.Parse(signToken(t, claims)); err == nil {
+		t.Fatal("expected expired token to be rejected")
+	}
+}
+
+func TestVerifierParse_MissingTenantClaim(t *testing.T) {
+	v := NewVerifier([]byte(testSecret), nil)
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "user-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles: []string{RoleTenantAdmin},
+	}
+
+	if _, err := v.Parse(signToken(t, claims)); err == nil {
+		t.Fatal("expected token missing tenant_id to be rejected")
+	}
+}
+
+func TestVerifierParse_MissingSubjectClaim(t *testing.T) {
+	v := NewVerifier([]byte(testSecret), nil)
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles:    []string{RoleTenantAdmin},
+		TenantID: "acme",
+	}
+
+	if _, err := v.Parse(signToken(t, claims)); err == nil {
+		t.Fatal("expected token missing sub to be rejected")
+	}
+}
+
+func TestVerifierParse_PlatformAdminWithoutTenantID(t *testing.T) {
+	v := NewVerifier([]byte(testSecret), nil)
+	claims := Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "admin-1",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Hour)),
+		},
+		Roles: []string{RolePlatformAdmin},
+	}
+
+	got, err := v.Parse(signToken(t, claims))
+	if err != nil {
+		t.Fatalf("expected platform.admin token without tenant_id to be accepted, got error: %v", err)
+	}
+	if got.TenantID != "" {
+		t.Errorf("expected empty tenant_id, got %q", got.TenantID)
+	}
+}
+
+func TestStaticPolicyProvider_CrossTenantDenied(t *testing.T) {
+	provider := NewStaticPolicyProvider()
+	claims := &Claims{Roles: []string{RoleTenantAdmin}, TenantID: "acme"}
+
+	if provider.Allow(claims, "GET", "getTenant", "other-tenant") {
+		t.Fatal("tenant.admin should not be allowed to access another tenant")
+	}
+	if !provider.Allow(claims, "GET", "getTenant", "acme") {
+		t.Fatal("tenant.admin should be allowed to access their own tenant")
+	}
+}
+
+func TestStaticPolicyProvider_TenantAdminCannotDelete(t *testing.T) {
+	provider := NewStaticPolicyProvider()
+	claims := &Claims{Roles: []string{RoleTenantAdmin}, TenantID: "acme"}
+
+	if provider.Allow(claims, "DELETE", "deleteTenant", "acme") {
+		t.Fatal("tenant.admin should not be allowed to delete tenants")
+	}
+}
+
+func TestStaticPolicyProvider_EmptyPathTenantRoutesStillGated(t *testing.T) {
+	// listTenants and jobStatus carry no {name} segment, so pathTenant is always "".
+	// Allow lets tenant.admin through on GET, but the handlers themselves must scope
+	// the response to claims.TenantID - see listTenantsHandler and jobStatusHandler.
+	provider := NewStaticPolicyProvider()
+	claims := &Claims{Roles: []string{RoleTenantAdmin}, TenantID: "acme"}
+
+	if !provider.Allow(claims, "GET", "listTenants", "") {
+		t.Fatal("tenant.admin should be allowed to call listTenants, scoping happens server-side")
+	}
+	if provider.Allow(claims, "DELETE", "listTenants", "") {
+		t.Fatal("tenant.admin should not be allowed to DELETE an unscoped route")
+	}
+}
+
+func TestStaticPolicyProvider_PlatformAdminAllowed(t *testing.T) {
+	provider := NewStaticPolicyProvider()
+	claims := &Claims{Roles: []string{RolePlatformAdmin}, TenantID: "acme"}
+
+	if !provider.Allow(claims, "DELETE", "deleteTenant", "some-other-tenant") {
+		t.Fatal("platform.admin should be allowed to manage any tenant")
+	}
+}
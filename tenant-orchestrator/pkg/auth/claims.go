@@ -0,0 +1,42 @@
+// Package auth provides JWT authentication and role-based authorization middleware for
+// the tenant orchestrator HTTP API.
+package auth
+
+import (
+	"context"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Claims are the identity facts extracted from a validated bearer token.
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles    []string `json:"roles"`
+	TenantID string   `json:"tenant_id"`
+}
+
+// HasRole reports whether the claims include the given role.
+func (c Claims) HasRole(role string) bool {
+	for _, r := range c.Roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+type contextKey int
+
+const claimsContextKey contextKey = iota
+
+// WithClaims returns a context carrying the given claims, used by Verifier.Middleware to
+// pass identity downstream to AuthzMiddleware and the handlers themselves.
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsContextKey, claims)
+}
+
+// ClaimsFromContext returns the claims stored by Verifier.Middleware, if any.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsContextKey).(*Claims)
+	return claims, ok
+}
@@ -0,0 +1,130 @@
+package auth
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"sync"
+	"time"
+)
+
+type jwksResponse struct {
+	Keys []jsonWebKey `json:"keys"`
+}
+
+type jsonWebKey struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// JWKSCache fetches and caches RS256 public keys from a JWKS endpoint, refreshing them in
+// the background so key rotation doesn't require an orchestrator restart.
+type JWKSCache struct {
+	url    string
+	client *http.Client
+
+	mu   sync.RWMutex
+	keys map[string]*rsa.PublicKey
+}
+
+// NewJWKSCache creates a cache that refreshes from url every refreshInterval.
+func NewJWKSCache(url string, refreshInterval time.Duration) *JWKSCache {
+	c := &JWKSCache{
+		url:    url,
+		client: &http.Client{Timeout: 5 * time.Second},
+		keys:   make(map[string]*rsa.PublicKey),
+	}
+	if err := c.refresh(); err != nil {
+		// Non-fatal: the first real lookup will retry the refresh.
+	}
+	if refreshInterval > 0 {
+		go c.refreshLoop(refreshInterval)
+	}
+	return c
+}
+
+func (c *JWKSCache) refreshLoop(interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for range ticker.C {
+		_ = c.refresh()
+	}
+}
+
+func (c *JWKSCache) refresh() error {
+	resp, err := c.client.Get(c.url)
+	if err != nil {
+		return fmt.Errorf("failed to fetch JWKS: %w", err)
+	}
+	defer resp.Body.Close()
+
+	var parsed jwksResponse
+	if err := json.NewDecoder(resp.Body).Decode(&parsed); err != nil {
+		return fmt.Errorf("failed to decode JWKS: %w", err)
+	}
+
+	keys := make(map[string]*rsa.PublicKey, len(parsed.Keys))
+	for _, k := range parsed.Keys {
+		if k.Kty != "RSA" {
+			continue
+		}
+		pubKey, err := jwkToRSAPublicKey(k)
+		if err != nil {
+			continue
+		}
+		keys[k.Kid] = pubKey
+	}
+
+	c.mu.Lock()
+	c.keys = keys
+	c.mu.Unlock()
+	return nil
+}
+
+// Key returns the public key for kid, refreshing the cache once if it isn't found — this
+// covers the window right after a key rotation.
+func (c *JWKSCache) Key(kid string) (*rsa.PublicKey, error) {
+	c.mu.RLock()
+	key, ok := c.keys[kid]
+	c.mu.RUnlock()
+	if ok {
+		return key, nil
+	}
+
+	if err := c.refresh(); err != nil {
+		return nil, err
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	key, ok = c.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("no JWKS key found for kid %q", kid)
+	}
+	return key, nil
+}
+
+func jwkToRSAPublicKey(k jsonWebKey) (*rsa.PublicKey, error) {
+	nBytes, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("invalid modulus: %w", err)
+	}
+	eBytes, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("invalid exponent: %w", err)
+	}
+
+	eBuf := make([]byte, 8)
+	copy(eBuf[8-len(eBytes):], eBytes)
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(nBytes),
+		E: int(binary.BigEndian.Uint64(eBuf)),
+	}, nil
+}
@@ -0,0 +1,84 @@
+package auth
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// Verifier validates bearer tokens signed with either HS256 (a shared secret) or RS256
+// (keys resolved from a JWKS endpoint).
+type Verifier struct {
+	hmacSecret []byte
+	jwks       *JWKSCache
+}
+
+// NewVerifier builds a Verifier. Either argument may be left zero-valued/nil if that
+// signing method isn't in use.
+func NewVerifier(hmacSecret []byte, jwks *JWKSCache) *Verifier {
+	return &Verifier{hmacSecret: hmacSecret, jwks: jwks}
+}
+
+// Parse validates tokenString and returns its claims, or an error describing why the
+// token was rejected (expired, malformed, missing claims, unknown signing key, ...).
+func (v *Verifier) Parse(tokenString string) (*Claims, error) {
+	claims := &Claims{}
+	token, err := jwt.ParseWithClaims(tokenString, claims, v.keyFunc, jwt.WithValidMethods([]string{"HS256", "RS256"}))
+	if err != nil {
+		return nil, fmt.Errorf("invalid token: %w", err)
+	}
+	if !token.Valid {
+		return nil, fmt.Errorf("invalid token")
+	}
+	if claims.Subject == "" {
+		return nil, fmt.Errorf("token missing sub claim")
+	}
+	// platform.admin is explicitly not bound to a single tenant (see policy.go), so only
+	// non-admin roles are required to carry a tenant_id.
+	if claims.TenantID == "" && !claims.HasRole(RolePlatformAdmin) {
+		return nil, fmt.Errorf("token missing tenant_id claim")
+	}
+	return claims, nil
+}
+
+func (v *Verifier) keyFunc(token *jwt.Token) (interface{}, error) {
+	switch token.Method.Alg() {
+	case "HS256":
+		if len(v.hmacSecret) == 0 {
+			return nil, fmt.Errorf("HS256 tokens are not accepted (no shared secret configured)")
+		}
+		return v.hmacSecret, nil
+	case "RS256":
+		if v.jwks == nil {
+			return nil, fmt.Errorf("RS256 tokens are not accepted (no JWKS configured)")
+		}
+		kid, _ := token.Header["kid"].(string)
+		return v.jwks.Key(kid)
+	default:
+		return nil, fmt.Errorf("unsupported signing method %q", token.Method.Alg())
+	}
+}
+
+// Middleware validates the Authorization bearer token on every request and stores the
+// resulting Claims on the request context for downstream handlers and AuthzMiddleware to use.
+func (v *Verifier) Middleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		const prefix = "Bearer "
+		if !strings.HasPrefix(header, prefix) {
+			http.Error(w, `{"success":false,"error":"missing bearer token"}`, http.StatusUnauthorized)
+			return
+		}
+
+		claims, err := v.Parse(strings.TrimPrefix(header, prefix))
+		if err != nil {
+			http.Error(w, fmt.Sprintf(`{"success":false,"error":%q}`, err.Error()), http.StatusUnauthorized)
+			return
+		}
+
+		r = r.WithContext(WithClaims(r.Context(), claims))
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,77 @@
+package auth
+
+import (
+	"net/http"
+
+	"github.com/gorilla/mux"
+)
+
+// PolicyProvider decides whether a caller's claims are allowed to perform method on the
+// named route, optionally scoped to a path tenant (the {name} URL segment, if any). This
+// is the seam a ConfigMap- or OPA-sourced policy set can slot into later.
+type PolicyProvider interface {
+	Allow(claims *Claims, method, routeName, pathTenant string) bool
+}
+
+const (
+	RolePlatformAdmin = "platform.admin"
+	RoleTenantAdmin   = "tenant.admin"
+)
+
+// StaticPolicyProvider implements the fixed role rules the orchestrator starts with:
+// platform.admin can manage any tenant, tenant.admin can only read/update their own.
+type StaticPolicyProvider struct{}
+
+// NewStaticPolicyProvider returns the default, hard-coded PolicyProvider.
+func NewStaticPolicyProvider() *StaticPolicyProvider {
+	return &StaticPolicyProvider{}
+}
+
+func (StaticPolicyProvider) Allow(claims *Claims, method, routeName, pathTenant string) bool {
+	if claims.HasRole(RolePlatformAdmin) {
+		return true
+	}
+
+	if !claims.HasRole(RoleTenantAdmin) {
+		return false
+	}
+
+	// tenant.admin may only touch their own tenant, and only read/update it.
+	if pathTenant != "" && pathTenant != claims.TenantID {
+		return false
+	}
+	switch method {
+	case http.MethodGet, http.MethodPatch, http.MethodPut:
+		return true
+	default:
+		return false
+	}
+}
+
+// AuthzMiddleware enforces provider's policy for every request, comparing the URL
+// {name} path variable (if present) against the caller's tenant_id claim rather than
+// trusting any client-supplied header.
+func AuthzMiddleware(provider PolicyProvider) mux.MiddlewareFunc {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			claims, ok := ClaimsFromContext(r.Context())
+			if !ok {
+				http.Error(w, `{"success":false,"error":"unauthenticated"}`, http.StatusUnauthorized)
+				return
+			}
+
+			pathTenant := mux.Vars(r)["name"]
+			routeName := ""
+			if route := mux.CurrentRoute(r); route != nil {
+				routeName = route.GetName()
+			}
+
+			if !provider.Allow(claims, r.Method, routeName, pathTenant) {
+				http.Error(w, `{"success":false,"error":"forbidden"}`, http.StatusForbidden)
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		})
+	}
+}
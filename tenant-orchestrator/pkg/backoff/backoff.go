@@ -0,0 +1,34 @@
+// Package backoff computes per-tenant exponential requeue delays, replacing the fixed
+// RequeueAfter intervals reconcilers would otherwise use on every retry. A tenant stuck
+// failing backs off instead of requeuing at the same cadence as a healthy one, so it can't
+// starve the worker pool.
+package backoff
+
+import (
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// Tracker computes exponentially growing backoff durations keyed by an arbitrary string,
+// typically a tenant name.
+type Tracker struct {
+	limiter workqueue.RateLimiter
+}
+
+// NewTracker creates a Tracker whose backoff starts at base and never exceeds max.
+func NewTracker(base, max time.Duration) *Tracker {
+	return &Tracker{limiter: workqueue.NewItemExponentialFailureRateLimiter(base, max)}
+}
+
+// Next returns the next backoff duration for key, growing exponentially each time it is
+// called again for the same key without an intervening Forget.
+func (t *Tracker) Next(key string) time.Duration {
+	return t.limiter.When(key)
+}
+
+// Forget resets key's backoff to base, e.g. once its reconcile reaches a steady healthy
+// state.
+func (t *Tracker) Forget(key string) {
+	t.limiter.Forget(key)
+}
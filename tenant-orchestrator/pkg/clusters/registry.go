@@ -0,0 +1,119 @@
+// Package clusters lets the orchestrator schedule tenants onto one of several Kubernetes
+// clusters instead of a single in-process client.
+package clusters
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Cluster is a single registered Kubernetes cluster tenants can be placed onto. Client
+// serves the typed Tenant CR reads/writes the reconciler and API need; Clientset serves
+// the raw core/v1 calls (pod listing, log streaming) that controller-runtime's client
+// doesn't cover.
+type Cluster struct {
+	Name      string
+	Client    client.Client
+	Clientset kubernetes.Interface
+}
+
+// Registry loads named kubeconfigs and caches a controller-runtime client per cluster.
+type Registry struct {
+	scheme *runtime.Scheme
+
+	mu       sync.RWMutex
+	clusters map[string]*Cluster
+	// defaultName is the first cluster added, returned by Default. The Scheduler does not
+	// use it: an unpinned tenant with no placement policy goes to the least-loaded cluster.
+	defaultName string
+}
+
+// NewRegistry creates an empty registry. Clusters are added with Add or LoadFromEnv.
+func NewRegistry(scheme *runtime.Scheme) *Registry {
+	return &Registry{
+		scheme:   scheme,
+		clusters: make(map[string]*Cluster),
+	}
+}
+
+// Add registers a cluster client and clientset under name. The first cluster added
+// becomes the default.
+func (reg *Registry) Add(name string, c client.Client, clientset kubernetes.Interface) {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.clusters[name] = &Cluster{Name: name, Client: c, Clientset: clientset}
+	if reg.defaultName == "" {
+		reg.defaultName = name
+	}
+}
+
+// LoadFromEnv loads additional clusters from a "name=/path/to/kubeconfig,name2=/path2"
+// formatted env var, mirroring the multi-kubeconfig GetKubeConfig(clusterLevel) pattern
+// used elsewhere to address multiple clusters by name.
+func (reg *Registry) LoadFromEnv(envVar string) error {
+	raw := os.Getenv(envVar)
+	if raw == "" {
+		return nil
+	}
+
+	for _, pair := range strings.Split(raw, ",") {
+		pair = strings.TrimSpace(pair)
+		if pair == "" {
+			continue
+		}
+		parts := strings.SplitN(pair, "=", 2)
+		if len(parts) != 2 {
+			return fmt.Errorf("invalid cluster entry %q, expected name=path", pair)
+		}
+		name, path := strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])
+
+		config, err := clientcmd.BuildConfigFromFlags("", path)
+		if err != nil {
+			return fmt.Errorf("failed to load kubeconfig for cluster %q: %w", name, err)
+		}
+		c, err := client.New(config, client.Options{Scheme: reg.scheme})
+		if err != nil {
+			return fmt.Errorf("failed to build client for cluster %q: %w", name, err)
+		}
+		cs, err := kubernetes.NewForConfig(config)
+		if err != nil {
+			return fmt.Errorf("failed to build clientset for cluster %q: %w", name, err)
+		}
+		reg.Add(name, c, cs)
+	}
+	return nil
+}
+
+// Get returns the cluster registered under name.
+func (reg *Registry) Get(name string) (*Cluster, bool) {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	c, ok := reg.clusters[name]
+	return c, ok
+}
+
+// List returns all registered clusters.
+func (reg *Registry) List() []*Cluster {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	out := make([]*Cluster, 0, len(reg.clusters))
+	for _, c := range reg.clusters {
+		out = append(out, c)
+	}
+	return out
+}
+
+// Default returns the registry's default cluster (the first one added).
+func (reg *Registry) Default() (*Cluster, bool) {
+	reg.mu.RLock()
+	defer reg.mu.RUnlock()
+	c, ok := reg.clusters[reg.defaultName]
+	return c, ok
+}
@@ -0,0 +1,139 @@
+package clusters
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+)
+
+// Placement policy values accepted on TenantCreateRequest.PlacementPolicy.
+const (
+	PolicyPinned      = "pinned"
+	PolicyLeastLoaded = "least-loaded"
+	regionPrefix      = "region:"
+)
+
+// Scheduler picks a target cluster for a tenant based on an explicit cluster name, a
+// placement policy, current tenant counts per cluster, and label selectors.
+type Scheduler struct {
+	registry *Registry
+}
+
+// NewScheduler creates a Scheduler backed by registry.
+func NewScheduler(registry *Registry) *Scheduler {
+	return &Scheduler{registry: registry}
+}
+
+// Select returns the cluster a new tenant should be placed on. clusterName pins placement
+// when non-empty (PolicyPinned); otherwise placementPolicy is consulted, falling back to
+// least-loaded scheduling across all registered clusters.
+func (s *Scheduler) Select(ctx context.Context, clusterName, placementPolicy string) (*Cluster, error) {
+	if clusterName != "" {
+		c, ok := s.registry.Get(clusterName)
+		if !ok {
+			return nil, fmt.Errorf("cluster %q is not registered", clusterName)
+		}
+		return c, nil
+	}
+
+	switch {
+	case strings.HasPrefix(placementPolicy, regionPrefix):
+		return s.selectByRegion(ctx, strings.TrimPrefix(placementPolicy, regionPrefix))
+	case placementPolicy == "", placementPolicy == PolicyLeastLoaded:
+		return s.selectLeastLoaded(ctx, s.registry.List())
+	default:
+		return nil, fmt.Errorf("unknown placement policy %q", placementPolicy)
+	}
+}
+
+// selectByRegion picks the least-loaded cluster whose name carries a "-<region>" suffix.
+// Cluster naming convention (e.g. "primary-eu") is how region is encoded today; a labeled
+// cluster metadata store would replace this once one exists.
+func (s *Scheduler) selectByRegion(ctx context.Context, region string) (*Cluster, error) {
+	var candidates []*Cluster
+	for _, c := range s.registry.List() {
+		if strings.HasSuffix(c.Name, "-"+region) {
+			candidates = append(candidates, c)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no cluster registered for region %q", region)
+	}
+	return s.selectLeastLoaded(ctx, candidates)
+}
+
+// selectLeastLoaded returns the cluster in all currently hosting the fewest tenants.
+// Clusters whose tenants can't be listed are skipped.
+func (s *Scheduler) selectLeastLoaded(ctx context.Context, all []*Cluster) (*Cluster, error) {
+	if len(all) == 0 {
+		return nil, fmt.Errorf("no clusters registered")
+	}
+
+	var (
+		mu      sync.Mutex
+		best    *Cluster
+		bestLen = -1
+		wg      sync.WaitGroup
+	)
+
+	for _, c := range all {
+		wg.Add(1)
+		go func(c *Cluster) {
+			defer wg.Done()
+			var list tenantv1alpha1.TenantList
+			if err := c.Client.List(ctx, &list); err != nil {
+				return
+			}
+			count := len(list.Items)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if bestLen == -1 || count < bestLen {
+				best, bestLen = c, count
+			}
+		}(c)
+	}
+	wg.Wait()
+
+	if best == nil {
+		return nil, fmt.Errorf("no clusters available for scheduling")
+	}
+	return best, nil
+}
+
+// PlacementIndex tracks which cluster each tenant landed on, so handlers that need to
+// address an existing tenant (get/delete) know where to look without fanning out.
+type PlacementIndex struct {
+	mu    sync.RWMutex
+	index map[string]string
+}
+
+// NewPlacementIndex creates an empty placement index.
+func NewPlacementIndex() *PlacementIndex {
+	return &PlacementIndex{index: make(map[string]string)}
+}
+
+// Set records that tenant is placed on cluster.
+func (p *PlacementIndex) Set(tenant, cluster string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.index[tenant] = cluster
+}
+
+// Get returns the cluster tenant was placed on, if known.
+func (p *PlacementIndex) Get(tenant string) (string, bool) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	c, ok := p.index[tenant]
+	return c, ok
+}
+
+// Delete removes a tenant from the index, e.g. once it has been deleted.
+func (p *PlacementIndex) Delete(tenant string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.index, tenant)
+}
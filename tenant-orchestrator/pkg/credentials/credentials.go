@@ -0,0 +1,293 @@
+// Package credentials generates and rotates database credentials for tenant Postgres
+// clusters. Neither the old nor the new password is ever an argv literal or an inline env
+// Value in the rotation Job's pod spec; both reach the Job only as env vars sourced via
+// valueFrom.secretKeyRef against the tenant's credentials Secret and the transient rotation
+// Secret, respectively.
+package credentials
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/events"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CredentialsAnnotation is stamped onto dependent Deployments' pod template on rotation so
+// a Secret change that the Deployment spec itself doesn't reference still triggers a
+// rollout and consumers re-mount the new password.
+const CredentialsAnnotation = "checksum/db-credentials"
+
+// passwordBytes is the size, before base64 encoding, of a generated password.
+const passwordBytes = 32
+
+// Generate returns a new CSPRNG-backed, base64-url-encoded password.
+func Generate() (string, error) {
+	b := make([]byte, passwordBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate password: %w", err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// Rotator rotates a tenant's database credentials on the schedule configured by
+// Spec.Database.CredentialRotation.
+type Rotator struct {
+	client   client.Client
+	recorder record.EventRecorder
+	events   *events.Recorder
+}
+
+// NewRotator creates a Rotator that reads/writes the credentials Secret and rotation Job
+// through c, reports outcomes through recorder, and durably records successful rotations
+// through eventsRecorder so downstream consumers can fan out from the outbox rather than
+// watching Kubernetes Events. eventsRecorder may be nil, in which case rotation is recorded
+// only through recorder as before.
+func NewRotator(c client.Client, recorder record.EventRecorder, eventsRecorder *events.Recorder) *Rotator {
+	return &Rotator{client: c, recorder: recorder, events: eventsRecorder}
+}
+
+// Due reports whether tenant's credentials are due for rotation per
+// Spec.Database.CredentialRotation and Status.DatabaseStatus.NextRotation.
+func (r *Rotator) Due(tenant *tenantv1alpha1.Tenant) bool {
+	rotation := tenant.Spec.Database.CredentialRotation
+	if rotation == nil || !rotation.Enabled {
+		return false
+	}
+	if tenant.Status.DatabaseStatus.NextRotation == nil {
+		return true
+	}
+	return !time.Now().Before(tenant.Status.DatabaseStatus.NextRotation.Time)
+}
+
+// rotationJobName and rotationSecretName are stable, not timestamped, so a Rotate call
+// made while a rotation is already in flight finds the same Job instead of starting a
+// second one.
+func rotationJobName(tenantName string) string { return fmt.Sprintf("%s-db-rotate", tenantName) }
+func rotationSecretName(tenantName string) string {
+	return fmt.Sprintf("%s-db-rotate-password", tenantName)
+}
+
+// Rotate drives database credential rotation to completion across repeated reconciles.
+// The first call generates a new password, stashes it in a transient Secret, and starts a
+// Job that applies it to the running primary via ALTER USER (authenticating with the
+// still-valid old credentials); the new password reaches the Job only as an env var
+// sourced from that Secret, never as an argv literal. While the Job is still running,
+// later calls are a no-op. Once it completes successfully, Rotate copies the new password
+// into the tenant's credentials Secret and rolls every Deployment in the tenant namespace
+// so consumers re-mount it, only then letting the rotation take effect -- consumers never
+// restart onto a password Postgres hasn't accepted yet.
+func (r *Rotator) Rotate(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+	jobName := rotationJobName(tenant.Name)
+
+	var job batchv1.Job
+	err := r.client.Get(ctx, types.NamespacedName{Name: jobName, Namespace: namespace}, &job)
+	switch {
+	case errors.IsNotFound(err):
+		return r.startRotation(ctx, tenant, namespace, jobName)
+	case err != nil:
+		return fmt.Errorf("failed to read rotation job %s: %w", jobName, err)
+	}
+
+	if job.Status.Succeeded == 0 && job.Status.Failed == 0 {
+		// Still running; the credentials Secret must not change until Postgres has
+		// actually accepted the new password.
+		return nil
+	}
+	return r.finishRotation(ctx, tenant, namespace, jobName, job.Status.Succeeded > 0)
+}
+
+// startRotation generates a new password, stores it in a transient Secret, and creates the
+// Job that applies it to the running primary.
+func (r *Rotator) startRotation(ctx context.Context, tenant *tenantv1alpha1.Tenant, namespace, jobName string) error {
+	secretName := fmt.Sprintf("%s-db-credentials", tenant.Name)
+
+	var secret corev1.Secret
+	if err := r.client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: namespace}, &secret); err != nil {
+		return fmt.Errorf("failed to read current credentials: %w", err)
+	}
+
+	newPassword, err := Generate()
+	if err != nil {
+		return err
+	}
+	username := string(secret.Data["username"])
+
+	rotationSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      rotationSecretName(tenant.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name":     tenant.Name,
+				"app.kubernetes.io/managed-by": "tenant-orchestrator",
+				"app.kubernetes.io/component":  "credential-rotation",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{"password": []byte(newPassword)},
+	}
+	if err := r.client.Create(ctx, rotationSecret); err != nil && !errors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create transient rotation secret: %w", err)
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      jobName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name":     tenant.Name,
+				"app.kubernetes.io/managed-by": "tenant-orchestrator",
+				"app.kubernetes.io/component":  "credential-rotation",
+			},
+		},
+		Spec: batchv1.JobSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					ServiceAccountName: fmt.Sprintf("%s-patroni", tenant.Name),
+					RestartPolicy:      corev1.RestartPolicyOnFailure,
+					Containers: []corev1.Container{
+						{
+							Name:  "alter-user",
+							Image: "postgres:15",
+							// NEW_PASSWORD is expanded by the shell at runtime from the
+							// container's own environment, not substituted into this
+							// Command string by Kubernetes, so the pod spec itself never
+							// carries the password.
+							Command: []string{
+								"sh", "-c",
+								fmt.Sprintf(`psql -h %s-db-primary -U %s -c "ALTER USER %s WITH PASSWORD '$NEW_PASSWORD'"`,
+									tenant.Name, username, username),
+							},
+							Env: []corev1.EnvVar{
+								{
+									Name: "PGPASSWORD",
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+											Key:                  "password",
+										},
+									},
+								},
+								{
+									Name: "NEW_PASSWORD",
+									ValueFrom: &corev1.EnvVarSource{
+										SecretKeyRef: &corev1.SecretKeySelector{
+											LocalObjectReference: corev1.LocalObjectReference{Name: rotationSecret.Name},
+											Key:                  "password",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	if err := r.client.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+		r.event(tenant, corev1.EventTypeWarning, "CredentialRotationFailed", err.Error())
+		return fmt.Errorf("failed to create rotation Job: %w", err)
+	}
+	return nil
+}
+
+// finishRotation is called once the rotation Job has stopped running. On success it copies
+// the new password from the transient Secret into the tenant's credentials Secret, rolls
+// dependent Deployments, and advances the rotation schedule; either way it cleans up the
+// Job once it is no longer needed to retry.
+func (r *Rotator) finishRotation(ctx context.Context, tenant *tenantv1alpha1.Tenant, namespace, jobName string, succeeded bool) error {
+	if !succeeded {
+		r.deleteRotationJob(ctx, namespace, jobName)
+		err := fmt.Errorf("credential rotation job %s failed", jobName)
+		r.event(tenant, corev1.EventTypeWarning, "CredentialRotationFailed", err.Error())
+		return err
+	}
+
+	secretName := fmt.Sprintf("%s-db-credentials", tenant.Name)
+	var secret corev1.Secret
+	if err := r.client.Get(ctx, types.NamespacedName{Name: secretName, Namespace: namespace}, &secret); err != nil {
+		return fmt.Errorf("failed to read current credentials: %w", err)
+	}
+	var rotationSecret corev1.Secret
+	if err := r.client.Get(ctx, types.NamespacedName{Name: rotationSecretName(tenant.Name), Namespace: namespace}, &rotationSecret); err != nil {
+		return fmt.Errorf("failed to read transient rotation secret: %w", err)
+	}
+
+	secret.Data["password"] = rotationSecret.Data["password"]
+	if err := r.client.Update(ctx, &secret); err != nil {
+		r.event(tenant, corev1.EventTypeWarning, "CredentialRotationFailed", err.Error())
+		return fmt.Errorf("failed to update credentials Secret: %w", err)
+	}
+
+	if err := r.rollDependents(ctx, namespace); err != nil {
+		r.event(tenant, corev1.EventTypeWarning, "CredentialRotationFailed", err.Error())
+		return err
+	}
+
+	r.deleteRotationJob(ctx, namespace, jobName)
+	if err := r.client.Delete(ctx, &rotationSecret); err != nil && !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to delete transient rotation secret: %w", err)
+	}
+
+	now := metav1.Now()
+	next := metav1.NewTime(now.Add(time.Duration(tenant.Spec.Database.CredentialRotation.IntervalDays) * 24 * time.Hour))
+	tenant.Status.DatabaseStatus.LastRotated = &now
+	tenant.Status.DatabaseStatus.NextRotation = &next
+
+	r.event(tenant, corev1.EventTypeNormal, "CredentialRotationSucceeded", "Rotated database credentials")
+	if r.events != nil {
+		if err := r.events.Record(ctx, tenant.Name, events.CredentialsRotated, "Rotated database credentials"); err != nil {
+			return fmt.Errorf("failed to record credential rotation: %w", err)
+		}
+	}
+	return nil
+}
+
+// deleteRotationJob removes the rotation Job (and its Pods, via background propagation) so
+// the next Due rotation starts clean.
+func (r *Rotator) deleteRotationJob(ctx context.Context, namespace, jobName string) {
+	propagation := metav1.DeletePropagationBackground
+	_ = r.client.Delete(ctx, &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: namespace}},
+		&client.DeleteOptions{PropagationPolicy: &propagation})
+}
+
+// rollDependents stamps every Deployment in namespace with a fresh checksum annotation so
+// the next rollout re-mounts the rotated Secret.
+func (r *Rotator) rollDependents(ctx context.Context, namespace string) error {
+	var deployments appsv1.DeploymentList
+	if err := r.client.List(ctx, &deployments, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("failed to list deployments for rollout: %w", err)
+	}
+
+	for i := range deployments.Items {
+		d := &deployments.Items[i]
+		if d.Spec.Template.Annotations == nil {
+			d.Spec.Template.Annotations = map[string]string{}
+		}
+		d.Spec.Template.Annotations[CredentialsAnnotation] = fmt.Sprintf("%d", time.Now().UnixNano())
+		if err := r.client.Update(ctx, d); err != nil {
+			return fmt.Errorf("failed to roll deployment %s: %w", d.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *Rotator) event(tenant *tenantv1alpha1.Tenant, eventType, reason, message string) {
+	if r.recorder == nil {
+		return
+	}
+	r.recorder.Event(tenant, eventType, reason, message)
+}
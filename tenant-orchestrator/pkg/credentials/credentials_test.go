@@ -0,0 +1,29 @@
+package credentials
+
+import (
+	"encoding/base64"
+	"testing"
+)
+
+func TestGenerate_IsUniqueAndDecodable(t *testing.T) {
+	a, err := Generate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	b, err := Generate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if a == b {
+		t.Fatalf("expected two generated passwords to differ, got %q twice", a)
+	}
+
+	decoded, err := base64.RawURLEncoding.DecodeString(a)
+	if err != nil {
+		t.Fatalf("expected generated password to be valid base64url: %v", err)
+	}
+	if len(decoded) != passwordBytes {
+		t.Errorf("expected %d decoded bytes, got %d", passwordBytes, len(decoded))
+	}
+}
@@ -0,0 +1,170 @@
+// Package discovery tracks each tenant's service endpoints and probes them for health,
+// backing the health Monitor's discovery Checker and the reconciler's service-discovery
+// push.
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+)
+
+// Protocol names the probe CheckServiceHealth uses for an Endpoint.
+type Protocol string
+
+const (
+	ProtocolHTTP  Protocol = "http"
+	ProtocolHTTPS Protocol = "https"
+	ProtocolTCP   Protocol = "tcp"
+	ProtocolGRPC  Protocol = "grpc"
+)
+
+// dialTimeout bounds an individual probe so one unreachable endpoint can't stall a tenant's
+// whole health check.
+const dialTimeout = 3 * time.Second
+
+// Endpoint is one service instance registered for a tenant.
+type Endpoint struct {
+	Service  string
+	Address  string // host:port
+	Protocol Protocol
+	// GRPCService is the service name passed to grpc.health.v1.Health/Check when Protocol is
+	// ProtocolGRPC. Empty checks the server's overall status, matching the convention used
+	// by grpc-health-probe and kubelet's gRPC probe.
+	GRPCService string
+}
+
+// HealthStatus is the outcome of probing one Endpoint.
+type HealthStatus struct {
+	// Status is "healthy", "unhealthy", or "unsupported".
+	Status  string
+	Message string
+}
+
+// Client tracks each tenant's registered endpoints and their last-known health, and probes
+// them on demand.
+type Client struct {
+	httpClient *http.Client
+
+	mu        sync.Mutex
+	endpoints map[string][]Endpoint
+	status    map[string]map[string]HealthStatus
+	grpcConns map[string]*grpcConn
+}
+
+// NewClient creates an empty discovery Client.
+func NewClient() *Client {
+	return &Client{
+		httpClient: &http.Client{Timeout: dialTimeout},
+		endpoints:  make(map[string][]Endpoint),
+		status:     make(map[string]map[string]HealthStatus),
+		grpcConns:  make(map[string]*grpcConn),
+	}
+}
+
+// GetTenantEndpoints returns the endpoints currently registered for tenantName.
+func (c *Client) GetTenantEndpoints(tenantName string) []Endpoint {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return append([]Endpoint(nil), c.endpoints[tenantName]...)
+}
+
+// UpdateServiceEndpoints recomputes tenant's registered endpoints from its spec. Each
+// service's in-cluster DNS name is registered as an HTTP endpoint on port 80, matching the
+// Service the reconciler creates for it. Each call replaces the tenant's whole endpoint
+// list, so an endpoint added with RegisterEndpoint is dropped by the next call.
+func (c *Client) UpdateServiceEndpoints(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	endpoints := make([]Endpoint, 0, len(tenant.Spec.Services))
+	for _, svc := range tenant.Spec.Services {
+		endpoints = append(endpoints, Endpoint{
+			Service:  svc.Name,
+			Address:  fmt.Sprintf("%s-%s-svc.tenant-%s.svc.cluster.local:80", tenant.Name, svc.Name, tenant.Name),
+			Protocol: ProtocolHTTP,
+		})
+	}
+
+	c.mu.Lock()
+	c.endpoints[tenant.Name] = endpoints
+	c.mu.Unlock()
+	return nil
+}
+
+// RegisterEndpoint adds or replaces a single endpoint for tenantName, e.g. so a caller can
+// register a gRPC or TCP endpoint that UpdateServiceEndpoints' HTTP default doesn't cover.
+// The endpoint stays registered only until the next UpdateServiceEndpoints call for the
+// tenant.
+func (c *Client) RegisterEndpoint(tenantName string, ep Endpoint) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for i, existing := range c.endpoints[tenantName] {
+		if existing.Service == ep.Service {
+			c.endpoints[tenantName][i] = ep
+			return
+		}
+	}
+	c.endpoints[tenantName] = append(c.endpoints[tenantName], ep)
+}
+
+// UpdateHealthStatus records the last-known HealthStatus for a tenant's service.
+func (c *Client) UpdateHealthStatus(tenantName, service string, status HealthStatus) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.status[tenantName] == nil {
+		c.status[tenantName] = make(map[string]HealthStatus)
+	}
+	c.status[tenantName][service] = status
+}
+
+// CheckServiceHealth probes ep according to its Protocol and returns the result. It does not
+// itself record the result; callers pass it to UpdateHealthStatus.
+func (c *Client) CheckServiceHealth(ctx context.Context, ep Endpoint) HealthStatus {
+	ctx, cancel := context.WithTimeout(ctx, dialTimeout)
+	defer cancel()
+
+	switch ep.Protocol {
+	case ProtocolGRPC:
+		return c.checkGRPC(ctx, ep)
+	case ProtocolTCP:
+		return checkTCP(ctx, ep)
+	case ProtocolHTTPS:
+		return checkHTTP(ctx, c.httpClient, "https", ep)
+	case ProtocolHTTP, "":
+		return checkHTTP(ctx, c.httpClient, "http", ep)
+	default:
+		return HealthStatus{Status: "unsupported", Message: fmt.Sprintf("unknown protocol %q", ep.Protocol)}
+	}
+}
+
+func checkHTTP(ctx context.Context, client *http.Client, scheme string, ep Endpoint) HealthStatus {
+	url := fmt.Sprintf("%s://%s/healthz", scheme, ep.Address)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return HealthStatus{Status: "unhealthy", Message: err.Error()}
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return HealthStatus{Status: "unhealthy", Message: err.Error()}
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return HealthStatus{Status: "unhealthy", Message: fmt.Sprintf("healthz returned status %d", resp.StatusCode)}
+	}
+	return HealthStatus{Status: "healthy"}
+}
+
+func checkTCP(ctx context.Context, ep Endpoint) HealthStatus {
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", ep.Address)
+	if err != nil {
+		return HealthStatus{Status: "unhealthy", Message: err.Error()}
+	}
+	conn.Close()
+	return HealthStatus{Status: "healthy"}
+}
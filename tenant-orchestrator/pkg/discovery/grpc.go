@@ -0,0 +1,73 @@
+package discovery
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+// grpcConn caches a dialed connection to a single address so repeated health checks on the
+// same endpoint don't pay a fresh TCP/TLS handshake every poll.
+type grpcConn struct {
+	mu   sync.Mutex
+	conn *grpc.ClientConn
+}
+
+// checkGRPC calls grpc.health.v1.Health/Check against ep, dialing lazily and reusing the
+// connection across calls via c.grpcConns. A SERVING response is healthy; NOT_SERVING and
+// UNKNOWN are unhealthy; a server that doesn't implement the health service at all
+// (codes.Unimplemented) is reported as a distinct "unsupported" status rather than folded
+// into "unhealthy", matching kubelet's gRPC probe semantics.
+func (c *Client) checkGRPC(ctx context.Context, ep Endpoint) HealthStatus {
+	conn, err := c.dialGRPC(ctx, ep.Address)
+	if err != nil {
+		return HealthStatus{Status: "unhealthy", Message: fmt.Sprintf("failed to dial: %s", err)}
+	}
+
+	resp, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: ep.GRPCService})
+	if err != nil {
+		if status.Code(err) == codes.Unimplemented {
+			return HealthStatus{Status: "unsupported", Message: "server does not implement grpc.health.v1.Health"}
+		}
+		return HealthStatus{Status: "unhealthy", Message: err.Error()}
+	}
+
+	switch resp.Status {
+	case healthpb.HealthCheckResponse_SERVING:
+		return HealthStatus{Status: "healthy"}
+	default:
+		return HealthStatus{Status: "unhealthy", Message: fmt.Sprintf("grpc health status %s", resp.Status)}
+	}
+}
+
+// dialGRPC returns a cached connection for address, dialing one if none exists yet. Dials
+// are non-blocking (grpc.WithBlock is not set), so a momentarily unreachable endpoint
+// doesn't stall the caller; Check will surface the failure instead.
+func (c *Client) dialGRPC(ctx context.Context, address string) (*grpc.ClientConn, error) {
+	c.mu.Lock()
+	entry, ok := c.grpcConns[address]
+	if !ok {
+		entry = &grpcConn{}
+		c.grpcConns[address] = entry
+	}
+	c.mu.Unlock()
+
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+	if entry.conn != nil {
+		return entry.conn, nil
+	}
+
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		return nil, err
+	}
+	entry.conn = conn
+	return conn, nil
+}
@@ -0,0 +1,86 @@
+// Package events persists tenant lifecycle transitions to a durable outbox and fans them
+// out to pluggable sinks (HTTP webhook, message bus, Kubernetes Event), replacing the
+// ad-hoc EventRecorder.Event calls scattered through the reconciler and the TODO HTTP POST
+// that notifyTenantReady never implemented. Recording a transition only writes a row; a
+// Worker drains it and marks it delivered once every sink has acked, so a crash between the
+// two never silently drops a transition the way posting straight to a single webhook would.
+package events
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+)
+
+// Transition names a tenant lifecycle change worth recording in the outbox.
+type Transition string
+
+const (
+	Provisioning       Transition = "Provisioning"
+	Active             Transition = "Active"
+	Failed             Transition = "Failed"
+	Terminating        Transition = "Terminating"
+	CredentialsRotated Transition = "CredentialsRotated"
+	BackupSucceeded    Transition = "BackupSucceeded"
+	BackupFailed       Transition = "BackupFailed"
+)
+
+// Event is a single tenant lifecycle transition recorded in the outbox.
+type Event struct {
+	ID         string     `json:"id"`
+	TenantName string     `json:"tenantName"`
+	Transition Transition `json:"transition"`
+	Message    string     `json:"message"`
+	Timestamp  time.Time  `json:"timestamp"`
+}
+
+// Outbox persists Events durably and tracks their delivery, so a crash between enqueuing
+// and publishing doesn't lose a transition the way an in-memory channel would.
+type Outbox interface {
+	// Enqueue durably records ev before any sink sees it.
+	Enqueue(ctx context.Context, ev Event) error
+	// Pending returns every Event not yet marked delivered, oldest first.
+	Pending(ctx context.Context) ([]Event, error)
+	// MarkDelivered records that id was successfully published to every sink.
+	MarkDelivered(ctx context.Context, id string) error
+}
+
+// Sink publishes an Event to one external consumer.
+type Sink interface {
+	// Name identifies the sink in logs and errors.
+	Name() string
+	Publish(ctx context.Context, ev Event) error
+}
+
+// Recorder is what reconcile code calls to record a lifecycle transition. It only writes
+// to the outbox; a Worker, possibly running in a different goroutine or process, is
+// responsible for actually delivering it to sinks.
+type Recorder struct {
+	outbox Outbox
+}
+
+// NewRecorder creates a Recorder backed by outbox.
+func NewRecorder(outbox Outbox) *Recorder {
+	return &Recorder{outbox: outbox}
+}
+
+// Record durably enqueues a lifecycle transition for tenantName.
+func (r *Recorder) Record(ctx context.Context, tenantName string, transition Transition, message string) error {
+	return r.outbox.Enqueue(ctx, Event{
+		ID:         newEventID(),
+		TenantName: tenantName,
+		Transition: transition,
+		Message:    message,
+		Timestamp:  time.Now(),
+	})
+}
+
+var eventCounter uint64
+
+// newEventID returns a process-unique event identifier, following the same scheme as
+// pkg/jobs' job IDs.
+func newEventID() string {
+	n := atomic.AddUint64(&eventCounter, 1)
+	return fmt.Sprintf("event-%d-%d", time.Now().UnixNano(), n)
+}
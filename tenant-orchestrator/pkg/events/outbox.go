@@ -0,0 +1,77 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// CRDOutbox persists the outbox as TenantEvent custom resources, so it needs no
+// infrastructure beyond the cluster the reconciler already talks to. It is the default
+// backend.
+type CRDOutbox struct {
+	client client.Client
+}
+
+// NewCRDOutbox creates a CRDOutbox backed by c.
+func NewCRDOutbox(c client.Client) *CRDOutbox {
+	return &CRDOutbox{client: c}
+}
+
+// Enqueue creates a TenantEvent for ev, with Status.Delivered left false.
+func (o *CRDOutbox) Enqueue(ctx context.Context, ev Event) error {
+	record := &tenantv1alpha1.TenantEvent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ev.ID,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name": ev.TenantName,
+			},
+		},
+		Spec: tenantv1alpha1.TenantEventSpec{
+			TenantName: ev.TenantName,
+			Transition: string(ev.Transition),
+			Message:    ev.Message,
+			Timestamp:  metav1.NewTime(ev.Timestamp),
+		},
+	}
+	return o.client.Create(ctx, record)
+}
+
+// Pending lists every TenantEvent not yet marked delivered, oldest first.
+func (o *CRDOutbox) Pending(ctx context.Context) ([]Event, error) {
+	var list tenantv1alpha1.TenantEventList
+	if err := o.client.List(ctx, &list); err != nil {
+		return nil, fmt.Errorf("failed to list outbox events: %w", err)
+	}
+
+	events := make([]Event, 0, len(list.Items))
+	for _, item := range list.Items {
+		if item.Status.Delivered {
+			continue
+		}
+		events = append(events, Event{
+			ID:         item.Name,
+			TenantName: item.Spec.TenantName,
+			Transition: Transition(item.Spec.Transition),
+			Message:    item.Spec.Message,
+			Timestamp:  item.Spec.Timestamp.Time,
+		})
+	}
+	sort.SliceStable(events, func(i, j int) bool { return events[i].Timestamp.Before(events[j].Timestamp) })
+	return events, nil
+}
+
+// MarkDelivered sets Status.Delivered on the TenantEvent named id.
+func (o *CRDOutbox) MarkDelivered(ctx context.Context, id string) error {
+	var record tenantv1alpha1.TenantEvent
+	if err := o.client.Get(ctx, types.NamespacedName{Name: id}, &record); err != nil {
+		return fmt.Errorf("failed to load outbox event %s: %w", id, err)
+	}
+	record.Status.Delivered = true
+	return o.client.Status().Update(ctx, &record)
+}
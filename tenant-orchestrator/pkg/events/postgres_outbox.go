@@ -0,0 +1,76 @@
+package events
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// PostgresOutbox persists the outbox in a dedicated Postgres table instead of a Kubernetes
+// CR, for operators who'd rather not grow etcd with event volume. The caller is
+// responsible for migrating the schema:
+//
+//	CREATE TABLE tenant_events (
+//	    id          TEXT PRIMARY KEY,
+//	    tenant_name TEXT NOT NULL,
+//	    transition  TEXT NOT NULL,
+//	    message     TEXT NOT NULL,
+//	    occurred_at TIMESTAMPTZ NOT NULL,
+//	    delivered   BOOLEAN NOT NULL DEFAULT false
+//	);
+type PostgresOutbox struct {
+	db *sql.DB
+}
+
+// NewPostgresOutbox creates a PostgresOutbox backed by db.
+func NewPostgresOutbox(db *sql.DB) *PostgresOutbox {
+	return &PostgresOutbox{db: db}
+}
+
+// Enqueue inserts a row for ev with delivered left false.
+func (o *PostgresOutbox) Enqueue(ctx context.Context, ev Event) error {
+	_, err := o.db.ExecContext(ctx,
+		`INSERT INTO tenant_events (id, tenant_name, transition, message, occurred_at, delivered)
+		 VALUES ($1, $2, $3, $4, $5, false)`,
+		ev.ID, ev.TenantName, string(ev.Transition), ev.Message, ev.Timestamp,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue outbox event: %w", err)
+	}
+	return nil
+}
+
+// Pending returns every row not yet marked delivered, oldest first.
+func (o *PostgresOutbox) Pending(ctx context.Context) ([]Event, error) {
+	rows, err := o.db.QueryContext(ctx,
+		`SELECT id, tenant_name, transition, message, occurred_at
+		 FROM tenant_events WHERE delivered = false ORDER BY occurred_at`,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list pending outbox events: %w", err)
+	}
+	defer rows.Close()
+
+	var events []Event
+	for rows.Next() {
+		var (
+			ev         Event
+			transition string
+		)
+		if err := rows.Scan(&ev.ID, &ev.TenantName, &transition, &ev.Message, &ev.Timestamp); err != nil {
+			return nil, fmt.Errorf("failed to scan outbox event: %w", err)
+		}
+		ev.Transition = Transition(transition)
+		events = append(events, ev)
+	}
+	return events, rows.Err()
+}
+
+// MarkDelivered sets delivered = true for id.
+func (o *PostgresOutbox) MarkDelivered(ctx context.Context, id string) error {
+	_, err := o.db.ExecContext(ctx, `UPDATE tenant_events SET delivered = true WHERE id = $1`, id)
+	if err != nil {
+		return fmt.Errorf("failed to mark outbox event %s delivered: %w", id, err)
+	}
+	return nil
+}
@@ -0,0 +1,156 @@
+package events
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// HTTPSink POSTs each Event as JSON to a webhook URL, signing the body with HMAC-SHA256 so
+// the receiver can verify it came from this orchestrator, and retrying transient failures
+// with a linear backoff up to maxRetries times.
+type HTTPSink struct {
+	url        string
+	secret     []byte
+	maxRetries int
+	httpClient *http.Client
+}
+
+// NewHTTPSink creates an HTTPSink that posts to url, signing with secret and retrying up to
+// maxRetries times.
+func NewHTTPSink(url string, secret []byte, maxRetries int) *HTTPSink {
+	return &HTTPSink{
+		url:        url,
+		secret:     secret,
+		maxRetries: maxRetries,
+		httpClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Name identifies the sink by its destination URL.
+func (s *HTTPSink) Name() string {
+	return fmt.Sprintf("http:%s", s.url)
+}
+
+// Publish sends ev to the webhook, retrying on transport errors and non-2xx responses.
+func (s *HTTPSink) Publish(ctx context.Context, ev Event) error {
+	body, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	signature := s.sign(body)
+
+	var lastErr error
+	for attempt := 0; attempt <= s.maxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(time.Duration(attempt) * time.Second)
+		}
+
+		req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, bytes.NewReader(body))
+		if err != nil {
+			return fmt.Errorf("failed to build webhook request: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("X-Tenant-Orchestrator-Signature", signature)
+
+		resp, err := s.httpClient.Do(req)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			return nil
+		}
+		lastErr = fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return fmt.Errorf("webhook delivery to %s failed after %d attempts: %w", s.url, s.maxRetries+1, lastErr)
+}
+
+// sign returns the hex-encoded HMAC-SHA256 of body.
+func (s *HTTPSink) sign(body []byte) string {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// TopicPublisher is the subset of a message-bus client a MessageBusSink needs to publish an
+// event. It is satisfied by e.g. *nats.Conn's Publish method or a thin Kafka producer
+// wrapper; tests can supply a fake.
+type TopicPublisher interface {
+	Publish(subject string, data []byte) error
+}
+
+// MessageBusSink publishes each Event as JSON to a fixed topic on a pluggable message bus
+// (NATS, Kafka, ...), so consumers like billing or monitoring can subscribe instead of
+// exposing an HTTP endpoint.
+type MessageBusSink struct {
+	publisher TopicPublisher
+	topic     string
+}
+
+// NewMessageBusSink creates a MessageBusSink that publishes to topic through publisher.
+func NewMessageBusSink(publisher TopicPublisher, topic string) *MessageBusSink {
+	return &MessageBusSink{publisher: publisher, topic: topic}
+}
+
+// Name identifies the sink by its topic.
+func (s *MessageBusSink) Name() string {
+	return fmt.Sprintf("bus:%s", s.topic)
+}
+
+// Publish marshals ev and publishes it to the configured topic.
+func (s *MessageBusSink) Publish(ctx context.Context, ev Event) error {
+	data, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("failed to marshal event: %w", err)
+	}
+	return s.publisher.Publish(s.topic, data)
+}
+
+// KubernetesEventSink records each Event as a corev1.Event against its Tenant, giving
+// `kubectl describe tenant` the same visibility ad-hoc EventRecorder.Event calls used to.
+type KubernetesEventSink struct {
+	client   client.Client
+	recorder record.EventRecorder
+}
+
+// NewKubernetesEventSink creates a KubernetesEventSink that looks up tenants through c and
+// records through recorder.
+func NewKubernetesEventSink(c client.Client, recorder record.EventRecorder) *KubernetesEventSink {
+	return &KubernetesEventSink{client: c, recorder: recorder}
+}
+
+// Name identifies the sink.
+func (s *KubernetesEventSink) Name() string {
+	return "kubernetes-event"
+}
+
+// Publish looks up ev's Tenant and records a corev1.Event against it.
+func (s *KubernetesEventSink) Publish(ctx context.Context, ev Event) error {
+	var tenant tenantv1alpha1.Tenant
+	if err := s.client.Get(ctx, types.NamespacedName{Name: ev.TenantName, Namespace: "default"}, &tenant); err != nil {
+		return fmt.Errorf("failed to look up tenant %s for event: %w", ev.TenantName, err)
+	}
+
+	eventType := corev1.EventTypeNormal
+	if ev.Transition == Failed || ev.Transition == BackupFailed {
+		eventType = corev1.EventTypeWarning
+	}
+
+	s.recorder.Event(&tenant, eventType, string(ev.Transition), ev.Message)
+	return nil
+}
@@ -0,0 +1,72 @@
+package events
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// Worker drains an Outbox on an interval and publishes each pending Event to every
+// registered Sink, marking it delivered only once all sinks have acked it. An Event that
+// fails to publish to any sink is retried on the next poll, so delivery survives a worker
+// crash or a temporarily unreachable sink, at the cost of sinks needing to tolerate
+// duplicate delivery.
+type Worker struct {
+	outbox   Outbox
+	sinks    []Sink
+	interval time.Duration
+}
+
+// NewWorker creates a Worker that polls outbox every interval and publishes to sinks.
+func NewWorker(outbox Outbox, interval time.Duration, sinks ...Sink) *Worker {
+	return &Worker{outbox: outbox, sinks: sinks, interval: interval}
+}
+
+// Run polls the outbox until ctx is canceled.
+func (w *Worker) Run(ctx context.Context) {
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			w.drain(ctx)
+		}
+	}
+}
+
+// drain publishes every pending event once to each sink.
+func (w *Worker) drain(ctx context.Context) {
+	log := log.FromContext(ctx)
+
+	pending, err := w.outbox.Pending(ctx)
+	if err != nil {
+		log.Error(err, "Failed to list pending outbox events")
+		return
+	}
+
+	for _, ev := range pending {
+		if err := w.publish(ctx, ev); err != nil {
+			log.Error(err, "Failed to publish outbox event, will retry next poll", "event", ev.ID)
+			continue
+		}
+		if err := w.outbox.MarkDelivered(ctx, ev.ID); err != nil {
+			log.Error(err, "Failed to mark outbox event delivered", "event", ev.ID)
+		}
+	}
+}
+
+// publish sends ev to every sink, stopping at the first failure so the event is retried in
+// full on the next poll rather than re-delivered only to the sinks that already succeeded.
+func (w *Worker) publish(ctx context.Context, ev Event) error {
+	for _, sink := range w.sinks {
+		if err := sink.Publish(ctx, ev); err != nil {
+			return fmt.Errorf("sink %s failed: %w", sink.Name(), err)
+		}
+	}
+	return nil
+}
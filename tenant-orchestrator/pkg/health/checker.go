@@ -0,0 +1,51 @@
+package health
+
+import (
+	"context"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+)
+
+// Severity controls how a failing Checker affects the tenant's overall health: a fatal
+// checker failing makes the tenant unhealthy, while a warning checker failing is reported in
+// the TenantHealthReport but leaves the tenant healthy.
+type Severity string
+
+const (
+	SeverityFatal   Severity = "fatal"
+	SeverityWarning Severity = "warning"
+)
+
+// Status is the outcome of a single Checker run.
+type Status struct {
+	Healthy bool
+	Message string
+}
+
+// Checker is a single named health probe a Monitor can run against a tenant. Built-in
+// checkers live alongside Monitor; operators can register their own from main.
+type Checker interface {
+	// Name identifies the checker in metrics and the aggregated TenantHealthReport, e.g.
+	// "discovery" or "workloads".
+	Name() string
+	// Check probes tenant and returns its current Status. A non-nil error is treated the
+	// same as an unhealthy Status with the error's message.
+	Check(ctx context.Context, tenant *tenantv1alpha1.Tenant) (Status, error)
+	// Severity reports whether this checker failing is fatal or just a warning.
+	Severity() Severity
+}
+
+// CheckerFunc adapts a plain function to the Checker interface for simple, stateless checks
+// that don't need their own type.
+type CheckerFunc struct {
+	CheckerName     string
+	CheckerSeverity Severity
+	Fn              func(ctx context.Context, tenant *tenantv1alpha1.Tenant) (Status, error)
+}
+
+func (f CheckerFunc) Name() string       { return f.CheckerName }
+func (f CheckerFunc) Severity() Severity { return f.CheckerSeverity }
+
+func (f CheckerFunc) Check(ctx context.Context, tenant *tenantv1alpha1.Tenant) (Status, error) {
+	return f.Fn(ctx, tenant)
+}
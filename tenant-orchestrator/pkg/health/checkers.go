@@ -0,0 +1,43 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/discovery"
+)
+
+// discoveryChecker is the built-in Checker that probes every endpoint the discovery client
+// knows about for a tenant. Any unhealthy endpoint fails the check, matching the monitor's
+// pre-registry behavior.
+type discoveryChecker struct {
+	discovery *discovery.Client
+}
+
+func newDiscoveryChecker(d *discovery.Client) *discoveryChecker {
+	return &discoveryChecker{discovery: d}
+}
+
+func (c *discoveryChecker) Name() string       { return "discovery" }
+func (c *discoveryChecker) Severity() Severity { return SeverityFatal }
+
+// Check probes every known endpoint for tenant and records its status with the discovery
+// client, same as the pre-registry CheckTenantHealth did inline.
+func (c *discoveryChecker) Check(ctx context.Context, tenant *tenantv1alpha1.Tenant) (Status, error) {
+	endpoints := c.discovery.GetTenantEndpoints(tenant.Name)
+
+	var unhealthy []string
+	for _, ep := range endpoints {
+		healthStatus := c.discovery.CheckServiceHealth(ctx, ep)
+		c.discovery.UpdateHealthStatus(tenant.Name, ep.Service, healthStatus)
+		if healthStatus.Status != "healthy" {
+			unhealthy = append(unhealthy, fmt.Sprintf("%s: %s", ep.Service, healthStatus.Message))
+		}
+	}
+
+	if len(unhealthy) > 0 {
+		return Status{Healthy: false, Message: fmt.Sprintf("%d service(s) unhealthy: %v", len(unhealthy), unhealthy)}, nil
+	}
+	return Status{Healthy: true}, nil
+}
@@ -1,125 +1,261 @@
+// Package health runs a registry of named health Checkers against a tenant and aggregates
+// their results, so adding a new probe (gRPC, PromQL, a user-defined check) never requires
+// touching the others.
 package health
 
 import (
 	"context"
 	"fmt"
+	"strings"
+	"sync"
+	"time"
 
 	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
 	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/discovery"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
-	appsv1 "k8s.io/api/apps/v1"
-	"k8s.io/apimachinery/pkg/api/errors"
-	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 var (
 	tenantHealth = promauto.NewGaugeVec(
 		prometheus.GaugeOpts{
 			Name: "tenant_health_status",
-			Help: "Health status of tenant services (1 = healthy, 0 = unhealthy)",
+			Help: "Health status of tenant checks (1 = healthy, 0 = unhealthy)",
 		},
-		[]string{"tenant", "service"},
+		[]string{"tenant", "check"},
+	)
+	tenantHealthProbeDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "tenant_health_probe_duration_seconds",
+			Help:    "Duration of individual tenant health probes",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"tenant", "check"},
+	)
+	tenantHealthProbeFailures = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tenant_health_probe_failures_total",
+			Help: "Count of failed tenant health probes",
+		},
+		[]string{"tenant", "check"},
 	)
 )
 
-// Monitor manages tenant health checks
+// defaultMaxConcurrentProbes bounds how many Checkers NewMonitor's Monitor runs at once
+// absent an explicit MaxConcurrentProbes.
+const defaultMaxConcurrentProbes = 4
+
+// defaultCheckTimeout bounds how long a single Checker may run absent an explicit
+// CheckTimeout.
+const defaultCheckTimeout = 10 * time.Second
+
+// CheckResult is one Checker's outcome from a single Run, after hysteresis has been applied.
+type CheckResult struct {
+	Name               string
+	Severity           Severity
+	Status             Status
+	Duration           time.Duration
+	LastTransitionTime time.Time
+}
+
+// TenantHealthReport is the aggregated outcome of running every registered Checker against a
+// tenant once.
+type TenantHealthReport struct {
+	Overall bool
+	Checks  []CheckResult
+}
+
+// historyKey identifies one Checker's hysteresis state for one tenant.
+type historyKey struct {
+	tenant string
+	check  string
+}
+
+// checkHistory tracks the effective (post-hysteresis) status of one tenant/check pair across
+// successive Runs, so a single flaky probe doesn't flip the Tenant's condition.
+type checkHistory struct {
+	effectiveHealthy   bool
+	consecutive        int
+	lastTransitionTime time.Time
+}
+
+// Monitor runs a registry of named Checkers against a tenant and aggregates their results. It
+// probes checkers concurrently, bounded by MaxConcurrentProbes, with a per-check timeout,
+// and applies hysteresis so a flapping check doesn't thrash the tenant's effective status.
 type Monitor struct {
-	client    client.Client
-	discovery *discovery.Client
+	client   client.Client
+	checkers []Checker
+
+	// MaxConcurrentProbes bounds how many Checkers run concurrently in Run.
+	MaxConcurrentProbes int
+	// CheckTimeout bounds how long a single Checker's Check may run; exceeding it counts as
+	// an unhealthy result. Zero disables the timeout.
+	CheckTimeout time.Duration
+	// UnhealthyThreshold is how many consecutive unhealthy probes a check needs before its
+	// effective status flips from healthy to unhealthy.
+	UnhealthyThreshold int
+	// HealthyThreshold is the same, in the recovering direction.
+	HealthyThreshold int
+
+	mu      sync.Mutex
+	history map[historyKey]*checkHistory
 }
 
-// NewMonitor creates a new health monitor
+// NewMonitor creates a Monitor with the built-in discovery and workload-readiness checkers
+// already registered, sane probing/hysteresis defaults, and pluggable Register for
+// additional checkers, e.g. user-defined ones wired up from main, before the Monitor is
+// first used.
 func NewMonitor(c client.Client, d *discovery.Client) *Monitor {
-	return &Monitor{
-		client:    c,
-		discovery: d,
+	m := &Monitor{
+		client:              c,
+		MaxConcurrentProbes: defaultMaxConcurrentProbes,
+		CheckTimeout:        defaultCheckTimeout,
+		UnhealthyThreshold:  3,
+		HealthyThreshold:    1,
+		history:             make(map[historyKey]*checkHistory),
 	}
+	m.Register(newDiscoveryChecker(d))
+	m.Register(NewReadyChecker(c))
+	return m
 }
 
-// CheckTenantHealth checks the health of all services for a tenant
-func (m *Monitor) CheckTenantHealth(ctx context.Context, tenant *tenantv1alpha1.Tenant) (bool, error) {
-	log := log.FromContext(ctx).WithValues("tenant", tenant.Name)
-	overallHealthy := true
-	var healthErrors []string
-
-	// Check service health using discovery client
-	endpoints := m.discovery.GetTenantEndpoints(tenant.Name)
-	for _, ep := range endpoints {
-		healthStatus := m.discovery.CheckServiceHealth(ctx, ep)
-		tenantHealth.WithLabelValues(tenant.Name, ep.Service).Set(boolToFloat64(healthStatus.Status == "healthy"))
-		if healthStatus.Status != "healthy" {
-			overallHealthy = false
-			healthErrors = append(healthErrors, fmt.Sprintf("Service %s: %s", ep.Service, healthStatus.Message))
-			log.Info("Service unhealthy", "service", ep.Service, "message", healthStatus.Message)
+// Register adds checker to the registry Run iterates.
+func (m *Monitor) Register(checker Checker) {
+	m.checkers = append(m.checkers, checker)
+}
+
+// Run probes every registered Checker against tenant concurrently (bounded by
+// MaxConcurrentProbes), records tenant_health_status, tenant_health_probe_duration_seconds,
+// and tenant_health_probe_failures_total per checker, and aggregates the post-hysteresis
+// results into a TenantHealthReport. A fatal-severity checker that is effectively unhealthy
+// fails the tenant; a warning-severity one does not.
+func (m *Monitor) Run(ctx context.Context, tenant *tenantv1alpha1.Tenant) (TenantHealthReport, error) {
+	results := make([]CheckResult, len(m.checkers))
+
+	concurrency := m.MaxConcurrentProbes
+	if concurrency <= 0 {
+		concurrency = len(m.checkers)
+	}
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+	sem := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	for i, checker := range m.checkers {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(i int, checker Checker) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			results[i] = m.probe(ctx, tenant, checker)
+		}(i, checker)
+	}
+	wg.Wait()
+
+	healthy := true
+	var failures []string
+	for _, result := range results {
+		if result.Status.Healthy {
+			continue
+		}
+		failures = append(failures, fmt.Sprintf("%s: %s", result.Name, result.Status.Message))
+		if result.Severity == SeverityFatal {
+			healthy = false
 		}
-		m.discovery.UpdateHealthStatus(tenant.Name, ep.Service, healthStatus)
 	}
 
-	// Check database health with better error handling
-	dbHealthy, err := m.checkDatabaseHealth(ctx, tenant)
-	if err != nil {
-		log.Info("Database health check failed", "error", err.Error())
-		healthErrors = append(healthErrors, fmt.Sprintf("Database: %s", err.Error()))
-		overallHealthy = false
+	report := TenantHealthReport{Overall: healthy, Checks: results}
+	if len(failures) > 0 {
+		return report, fmt.Errorf("health issues detected: %s", strings.Join(failures, "; "))
+	}
+	return report, nil
+}
+
+// probe runs checker against tenant with a per-check timeout, records its metrics, and
+// applies hysteresis to produce the effective CheckResult.
+func (m *Monitor) probe(ctx context.Context, tenant *tenantv1alpha1.Tenant, checker Checker) CheckResult {
+	checkCtx := ctx
+	if m.CheckTimeout > 0 {
+		var cancel context.CancelFunc
+		checkCtx, cancel = context.WithTimeout(ctx, m.CheckTimeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	status, err := checker.Check(checkCtx, tenant)
+	duration := time.Since(start)
+
+	switch {
+	case checkCtx.Err() == context.DeadlineExceeded:
+		status = Status{Healthy: false, Message: fmt.Sprintf("check timed out after %s", m.CheckTimeout)}
+	case err != nil:
+		status = Status{Healthy: false, Message: err.Error()}
 	}
-	tenantHealth.WithLabelValues(tenant.Name, "database").Set(boolToFloat64(dbHealthy))
 
-	// Return aggregated error if there are health issues
-	if len(healthErrors) > 0 {
-		return overallHealthy, fmt.Errorf("health issues detected: %v", healthErrors)
+	tenantHealthProbeDuration.WithLabelValues(tenant.Name, checker.Name()).Observe(duration.Seconds())
+	if !status.Healthy {
+		tenantHealthProbeFailures.WithLabelValues(tenant.Name, checker.Name()).Inc()
 	}
 
-	return overallHealthy, nil
+	effective, lastTransition := m.applyHysteresis(tenant.Name, checker.Name(), status.Healthy)
+	effectiveStatus := status
+	effectiveStatus.Healthy = effective
+	tenantHealth.WithLabelValues(tenant.Name, checker.Name()).Set(boolToFloat64(effective))
+
+	return CheckResult{
+		Name:               checker.Name(),
+		Severity:           checker.Severity(),
+		Status:             effectiveStatus,
+		Duration:           duration,
+		LastTransitionTime: lastTransition,
+	}
 }
 
-// checkDatabaseHealth performs a health check on the tenant's database
-func (m *Monitor) checkDatabaseHealth(ctx context.Context, tenant *tenantv1alpha1.Tenant) (bool, error) {
-	log := log.FromContext(ctx).WithValues("tenant", tenant.Name)
-	
-	statefulSet := &appsv1.StatefulSet{}
-	err := m.client.Get(ctx, types.NamespacedName{
-		Name:      fmt.Sprintf("%s-db", tenant.Name),
-		Namespace: fmt.Sprintf("tenant-%s", tenant.Name),
-	}, statefulSet)
-	
-	if err != nil {
-		if errors.IsNotFound(err) {
-			log.Info("Database StatefulSet not found, may still be creating")
-			return false, fmt.Errorf("database StatefulSet not found")
-		}
-		log.Error(err, "Failed to get database StatefulSet")
-		return false, fmt.Errorf("failed to get database StatefulSet: %w", err)
+// applyHysteresis folds a raw probe result into the tenant/check's tracked history and
+// returns the effective status plus when it last transitioned. A status only flips once it
+// has been observed UnhealthyThreshold (or HealthyThreshold, recovering) times in a row;
+// until then the previous effective status holds.
+func (m *Monitor) applyHysteresis(tenantName, checkName string, healthy bool) (bool, time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := historyKey{tenant: tenantName, check: checkName}
+	h, ok := m.history[key]
+	if !ok {
+		h = &checkHistory{effectiveHealthy: healthy, lastTransitionTime: time.Now()}
+		m.history[key] = h
+		return h.effectiveHealthy, h.lastTransitionTime
 	}
 
-	// Check if StatefulSet is ready
-	if statefulSet.Status.ReadyReplicas == 0 {
-		// More detailed status checking
-		if statefulSet.Status.Replicas == 0 {
-			log.Info("Database StatefulSet has no replicas yet")
-			return false, fmt.Errorf("database StatefulSet has no replicas")
-		}
-		
-		if statefulSet.Status.CurrentReplicas > 0 && statefulSet.Status.ReadyReplicas == 0 {
-			log.Info("Database StatefulSet replicas are starting but not ready yet", 
-				"current", statefulSet.Status.CurrentReplicas, 
-				"ready", statefulSet.Status.ReadyReplicas)
-			return false, fmt.Errorf("database replicas are starting but not ready yet")
-		}
-		
-		log.Info("Database StatefulSet has no ready replicas", 
-			"replicas", statefulSet.Status.Replicas,
-			"currentReplicas", statefulSet.Status.CurrentReplicas,
-			"readyReplicas", statefulSet.Status.ReadyReplicas)
-		return false, fmt.Errorf("database StatefulSet has no ready replicas")
-	}
-
-	log.Info("Database health check passed", 
-		"readyReplicas", statefulSet.Status.ReadyReplicas,
-		"totalReplicas", statefulSet.Status.Replicas)
-	return true, nil
+	if healthy == h.effectiveHealthy {
+		h.consecutive = 0
+		return h.effectiveHealthy, h.lastTransitionTime
+	}
+
+	threshold := m.UnhealthyThreshold
+	if healthy {
+		threshold = m.HealthyThreshold
+	}
+	if threshold < 1 {
+		threshold = 1
+	}
+
+	h.consecutive++
+	if h.consecutive >= threshold {
+		h.effectiveHealthy = healthy
+		h.consecutive = 0
+		h.lastTransitionTime = time.Now()
+	}
+	return h.effectiveHealthy, h.lastTransitionTime
+}
+
+// CheckTenantHealth runs the registered checkers and flattens the result to the bool+error
+// shape callers relied on before Run returned a structured TenantHealthReport.
+func (m *Monitor) CheckTenantHealth(ctx context.Context, tenant *tenantv1alpha1.Tenant) (bool, error) {
+	report, err := m.Run(ctx, tenant)
+	return report.Overall, err
 }
 
 // boolToFloat64 converts a boolean to a Prometheus-compatible float64
@@ -128,4 +264,4 @@ func boolToFloat64(b bool) float64 {
 		return 1.0
 	}
 	return 0.0
-}
\ No newline at end of file
+}
@@ -0,0 +1,138 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+)
+
+func newTestMonitor(unhealthyThreshold, healthyThreshold int) *Monitor {
+	return &Monitor{
+		MaxConcurrentProbes: defaultMaxConcurrentProbes,
+		CheckTimeout:        defaultCheckTimeout,
+		UnhealthyThreshold:  unhealthyThreshold,
+		HealthyThreshold:    healthyThreshold,
+		history:             make(map[historyKey]*checkHistory),
+	}
+}
+
+func TestApplyHysteresis_FlapBelowThresholdStaysPut(t *testing.T) {
+	m := newTestMonitor(3, 1)
+
+	effective, _ := m.applyHysteresis("acme", "discovery", true)
+	if !effective {
+		t.Fatalf("expected first observation to establish healthy=true")
+	}
+
+	// A single unhealthy blip, then back to healthy, never reaches UnhealthyThreshold=3.
+	effective, _ = m.applyHysteresis("acme", "discovery", false)
+	if !effective {
+		t.Errorf("expected effective status to stay healthy after one unhealthy observation, got unhealthy")
+	}
+	effective, _ = m.applyHysteresis("acme", "discovery", true)
+	if !effective {
+		t.Errorf("expected effective status to remain healthy after flapping back, got unhealthy")
+	}
+}
+
+func TestApplyHysteresis_ConsecutiveFailuresFlip(t *testing.T) {
+	m := newTestMonitor(3, 1)
+
+	if effective, _ := m.applyHysteresis("acme", "discovery", true); !effective {
+		t.Fatalf("expected first observation to establish healthy=true")
+	}
+
+	for i := 0; i < 2; i++ {
+		if effective, _ := m.applyHysteresis("acme", "discovery", false); !effective {
+			t.Fatalf("expected status to remain healthy before UnhealthyThreshold is reached (observation %d)", i+1)
+		}
+	}
+
+	effective, transition := m.applyHysteresis("acme", "discovery", false)
+	if effective {
+		t.Fatalf("expected status to flip unhealthy after 3 consecutive failures")
+	}
+	if transition.IsZero() {
+		t.Errorf("expected a non-zero lastTransitionTime once the status flips")
+	}
+}
+
+func TestApplyHysteresis_RecoveryRequiresHealthyThreshold(t *testing.T) {
+	m := newTestMonitor(1, 2)
+
+	if effective, _ := m.applyHysteresis("acme", "discovery", false); effective {
+		t.Fatalf("expected first observation to establish healthy=false")
+	}
+
+	if effective, _ := m.applyHysteresis("acme", "discovery", true); effective {
+		t.Fatalf("expected status to stay unhealthy after only one healthy observation (HealthyThreshold=2)")
+	}
+
+	if effective, _ := m.applyHysteresis("acme", "discovery", true); !effective {
+		t.Fatalf("expected status to recover to healthy after HealthyThreshold=2 consecutive healthy observations")
+	}
+}
+
+func TestApplyHysteresis_IndependentPerTenantAndCheck(t *testing.T) {
+	m := newTestMonitor(3, 1)
+
+	m.applyHysteresis("acme", "discovery", true)
+	m.applyHysteresis("acme", "workloads", false)
+
+	if effective, _ := m.applyHysteresis("acme", "discovery", false); !effective {
+		t.Errorf("a failure on the workloads check must not affect the discovery check's history")
+	}
+}
+
+// TestRun_ConcurrentProbesNoRace registers many checkers, each reporting its own index in its
+// Status.Message, and asserts every slot in the resulting report lines up with the checker
+// that was supposed to fill it. Run with `go test -race` to catch a goroutine writing to the
+// wrong results[i] slot.
+func TestRun_ConcurrentProbesNoRace(t *testing.T) {
+	const numCheckers = 50
+
+	m := &Monitor{
+		MaxConcurrentProbes: 8,
+		CheckTimeout:        defaultCheckTimeout,
+		UnhealthyThreshold:  1,
+		HealthyThreshold:    1,
+		history:             make(map[historyKey]*checkHistory),
+	}
+
+	for i := 0; i < numCheckers; i++ {
+		idx := i
+		m.Register(CheckerFunc{
+			CheckerName:     fmt.Sprintf("check-%d", idx),
+			CheckerSeverity: SeverityWarning,
+			Fn: func(ctx context.Context, tenant *tenantv1alpha1.Tenant) (Status, error) {
+				time.Sleep(time.Millisecond)
+				return Status{Healthy: idx%2 == 0, Message: fmt.Sprintf("result-%d", idx)}, nil
+			},
+		})
+	}
+
+	tenant := &tenantv1alpha1.Tenant{}
+	tenant.Name = "acme"
+
+	report, _ := m.Run(context.Background(), tenant)
+
+	if len(report.Checks) != numCheckers {
+		t.Fatalf("expected %d results, got %d", numCheckers, len(report.Checks))
+	}
+	for i, result := range report.Checks {
+		wantName := fmt.Sprintf("check-%d", i)
+		wantMessage := fmt.Sprintf("result-%d", i)
+		if result.Name != wantName {
+			t.Errorf("result[%d]: expected name %q, got %q (slot clobbered)", i, wantName, result.Name)
+		}
+		if result.Status.Message != wantMessage {
+			t.Errorf("result[%d]: expected message %q, got %q (slot clobbered)", i, wantMessage, result.Status.Message)
+		}
+		if result.Status.Healthy != (i%2 == 0) {
+			t.Errorf("result[%d]: expected healthy=%v, got %v", i, i%2 == 0, result.Status.Healthy)
+		}
+	}
+}
@@ -0,0 +1,177 @@
+package health
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	promapi "github.com/prometheus/client_golang/api"
+	promv1 "github.com/prometheus/client_golang/api/prometheus/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// PrometheusChecker evaluates each of a tenant's Spec.HealthChecks.Prometheus SLO
+// expressions against an in-cluster Prometheus. It gates health the way a Prometheus
+// alerting rule's `for:` clause gates firing: a threshold breach only fails the tenant once
+// it has held continuously across successive Checks for the configured duration, not on the
+// first sample that crosses it.
+type PrometheusChecker struct {
+	api promv1.API
+
+	mu         sync.Mutex
+	violatedAt map[violationKey]time.Time
+}
+
+// violationKey identifies one SLO check for one tenant, so violatedAt can track each
+// independently.
+type violationKey struct {
+	tenant string
+	check  string
+}
+
+// NewPrometheusChecker creates a PrometheusChecker that queries the Prometheus reachable at
+// address, e.g. "http://prometheus-operated.monitoring.svc:9090".
+func NewPrometheusChecker(address string) (*PrometheusChecker, error) {
+	c, err := promapi.NewClient(promapi.Config{Address: address})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prometheus client: %w", err)
+	}
+	return &PrometheusChecker{
+		api:        promv1.NewAPI(c),
+		violatedAt: make(map[violationKey]time.Time),
+	}, nil
+}
+
+func (c *PrometheusChecker) Name() string       { return "prometheus-slo" }
+func (c *PrometheusChecker) Severity() Severity { return SeverityFatal }
+
+// Check evaluates every check in tenant.Spec.HealthChecks.Prometheus, records each one's
+// current value and evaluation time on the Tenant's status conditions, and reports unhealthy
+// only if a fatal-severity check has been breached continuously for its configured `for`
+// duration.
+func (c *PrometheusChecker) Check(ctx context.Context, tenant *tenantv1alpha1.Tenant) (Status, error) {
+	checks := tenant.Spec.HealthChecks.Prometheus
+	if len(checks) == 0 {
+		return Status{Healthy: true}, nil
+	}
+
+	now := time.Now()
+	var notes []string
+	fatalBreach := false
+
+	for _, check := range checks {
+		condType := check.Name + "SLO"
+
+		value, err := c.evaluate(ctx, tenant.Name, check)
+		if err != nil {
+			meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+				Type:    condType,
+				Status:  metav1.ConditionUnknown,
+				Reason:  "EvaluationFailed",
+				Message: fmt.Sprintf("query failed at %s: %s", now.Format(time.RFC3339), err.Error()),
+			})
+			notes = append(notes, fmt.Sprintf("%s: evaluation failed: %s", check.Name, err.Error()))
+			continue
+		}
+
+		breached := compareValue(value, check.Comparison, check.Threshold)
+		firing := c.trackViolation(violationKey{tenant: tenant.Name, check: check.Name}, breached, now, check.For.Duration)
+
+		status, reason := metav1.ConditionTrue, "WithinThreshold"
+		if firing {
+			status, reason = metav1.ConditionFalse, "ThresholdBreached"
+		}
+		meta.SetStatusCondition(&tenant.Status.Conditions, metav1.Condition{
+			Type:   condType,
+			Status: status,
+			Reason: reason,
+			Message: fmt.Sprintf("value=%g %s %g evaluatedAt=%s", value, check.Comparison, check.Threshold,
+				now.Format(time.RFC3339)),
+		})
+
+		if !firing {
+			continue
+		}
+		notes = append(notes, fmt.Sprintf("%s: value %g %s %g for >= %s", check.Name, value, check.Comparison, check.Threshold, check.For.Duration))
+		if check.Severity == string(SeverityFatal) {
+			fatalBreach = true
+		}
+	}
+
+	if len(notes) > 0 {
+		return Status{Healthy: !fatalBreach, Message: strings.Join(notes, "; ")}, nil
+	}
+	return Status{Healthy: true}, nil
+}
+
+// evaluate renders check.Query as a text/template with {{ .Tenant }} bound to tenantName,
+// issues an instant Prometheus query, and returns the first sample's value.
+func (c *PrometheusChecker) evaluate(ctx context.Context, tenantName string, check tenantv1alpha1.PrometheusCheckSpec) (float64, error) {
+	query, err := renderQuery(check.Query, tenantName)
+	if err != nil {
+		return 0, fmt.Errorf("failed to render query: %w", err)
+	}
+
+	result, _, err := c.api.Query(ctx, query, time.Now())
+	if err != nil {
+		return 0, fmt.Errorf("query failed: %w", err)
+	}
+
+	vector, ok := result.(model.Vector)
+	if !ok || len(vector) == 0 {
+		return 0, fmt.Errorf("query returned no samples")
+	}
+	return float64(vector[0].Value), nil
+}
+
+// trackViolation records when a check first breached its threshold and reports whether it
+// has now held continuously for at least forDuration. A non-breaching sample resets the
+// tracked violation, mirroring a Prometheus alert recovering before its `for:` clause fires.
+func (c *PrometheusChecker) trackViolation(key violationKey, breached bool, now time.Time, forDuration time.Duration) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !breached {
+		delete(c.violatedAt, key)
+		return false
+	}
+
+	first, ok := c.violatedAt[key]
+	if !ok {
+		c.violatedAt[key] = now
+		first = now
+	}
+	return now.Sub(first) >= forDuration
+}
+
+func renderQuery(query, tenantName string) (string, error) {
+	tmpl, err := template.New("query").Parse(query)
+	if err != nil {
+		return "", err
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, struct{ Tenant string }{Tenant: tenantName}); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func compareValue(value float64, comparison string, threshold float64) bool {
+	switch comparison {
+	case "<":
+		return value < threshold
+	case ">":
+		return value > threshold
+	case "==":
+		return value == threshold
+	default:
+		return false
+	}
+}
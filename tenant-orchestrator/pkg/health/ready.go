@@ -0,0 +1,278 @@
+package health
+
+import (
+	"context"
+	"fmt"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// tenantLabel is stamped on every namespaced object a tenant owns, whether created by this
+// operator or a Helm chart deployed into the tenant's namespace. ReadyChecker selects on it
+// instead of a hard-coded resource name.
+const tenantLabel = "tenant.rezenkai.com/name"
+
+var tenantWorkloadReady = promauto.NewGaugeVec(
+	prometheus.GaugeOpts{
+		Name: "tenant_workload_ready",
+		Help: "Readiness of an individual tenant workload (1 = ready, 0 = not ready)",
+	},
+	[]string{"tenant", "kind", "name"},
+)
+
+// ReadyChecker is the built-in Checker that inspects every workload labeled tenantLabel for
+// a tenant, across the resource kinds a tenant's own manifests or a Helm chart may deploy:
+// Deployment, StatefulSet, DaemonSet, Job, PersistentVolumeClaim, and
+// CustomResourceDefinition. Driving the list from labels rather than a hard-coded name means
+// any workload the operator or a chart creates for the tenant participates in health.
+type ReadyChecker struct {
+	client client.Client
+}
+
+// NewReadyChecker creates a ReadyChecker that lists workloads through c.
+func NewReadyChecker(c client.Client) *ReadyChecker {
+	return &ReadyChecker{client: c}
+}
+
+func (c *ReadyChecker) Name() string       { return "workloads" }
+func (c *ReadyChecker) Severity() Severity { return SeverityFatal }
+
+// Check lists every supported kind labeled tenantLabel=tenant.Name, reports each resource's
+// readiness via tenant_workload_ready, and fails if any resource isn't ready.
+func (c *ReadyChecker) Check(ctx context.Context, tenant *tenantv1alpha1.Tenant) (Status, error) {
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+	selector := client.MatchingLabels{tenantLabel: tenant.Name}
+
+	var results []workloadResult
+	var err error
+
+	if results, err = c.checkDeployments(ctx, namespace, selector, results); err != nil {
+		return Status{}, err
+	}
+	if results, err = c.checkStatefulSets(ctx, namespace, selector, results); err != nil {
+		return Status{}, err
+	}
+	if results, err = c.checkDaemonSets(ctx, namespace, selector, results); err != nil {
+		return Status{}, err
+	}
+	if results, err = c.checkJobs(ctx, namespace, selector, results); err != nil {
+		return Status{}, err
+	}
+	if results, err = c.checkPVCs(ctx, namespace, selector, results); err != nil {
+		return Status{}, err
+	}
+	if results, err = c.checkCRDs(ctx, selector, results); err != nil {
+		return Status{}, err
+	}
+
+	var notReady []string
+	for _, r := range results {
+		tenantWorkloadReady.WithLabelValues(tenant.Name, r.kind, r.name).Set(boolToFloat64(r.ready))
+		if !r.ready {
+			notReady = append(notReady, fmt.Sprintf("%s/%s: %s", r.kind, r.name, r.reason))
+		}
+	}
+
+	if len(notReady) > 0 {
+		return Status{Healthy: false, Message: fmt.Sprintf("%d workload(s) not ready: %v", len(notReady), notReady)}, nil
+	}
+	return Status{Healthy: true, Message: fmt.Sprintf("%d workload(s) ready", len(results))}, nil
+}
+
+// workloadResult is one labeled resource's readiness, ahead of being turned into a
+// tenant_workload_ready sample.
+type workloadResult struct {
+	kind   string
+	name   string
+	ready  bool
+	reason string
+}
+
+func (c *ReadyChecker) checkDeployments(ctx context.Context, namespace string, selector client.MatchingLabels, results []workloadResult) ([]workloadResult, error) {
+	var list appsv1.DeploymentList
+	if err := c.client.List(ctx, &list, client.InNamespace(namespace), selector); err != nil {
+		return nil, fmt.Errorf("failed to list deployments: %w", err)
+	}
+	for i := range list.Items {
+		ready, reason := deploymentReady(&list.Items[i])
+		results = append(results, workloadResult{kind: "Deployment", name: list.Items[i].Name, ready: ready, reason: reason})
+	}
+	return results, nil
+}
+
+func (c *ReadyChecker) checkStatefulSets(ctx context.Context, namespace string, selector client.MatchingLabels, results []workloadResult) ([]workloadResult, error) {
+	var list appsv1.StatefulSetList
+	if err := c.client.List(ctx, &list, client.InNamespace(namespace), selector); err != nil {
+		return nil, fmt.Errorf("failed to list statefulsets: %w", err)
+	}
+	for i := range list.Items {
+		ready, reason := statefulSetReady(&list.Items[i])
+		results = append(results, workloadResult{kind: "StatefulSet", name: list.Items[i].Name, ready: ready, reason: reason})
+	}
+	return results, nil
+}
+
+func (c *ReadyChecker) checkDaemonSets(ctx context.Context, namespace string, selector client.MatchingLabels, results []workloadResult) ([]workloadResult, error) {
+	var list appsv1.DaemonSetList
+	if err := c.client.List(ctx, &list, client.InNamespace(namespace), selector); err != nil {
+		return nil, fmt.Errorf("failed to list daemonsets: %w", err)
+	}
+	for i := range list.Items {
+		ready, reason := daemonSetReady(&list.Items[i])
+		results = append(results, workloadResult{kind: "DaemonSet", name: list.Items[i].Name, ready: ready, reason: reason})
+	}
+	return results, nil
+}
+
+// credentialRotationComponent marks the one-shot Jobs pkg/credentials creates to rotate a
+// tenant's database password. They're excluded from readiness: a routine rotation Job is
+// "still running", not failed, for its whole lifetime, and ReadyChecker is SeverityFatal,
+// so counting it would flip a healthy tenant unhealthy every time a rotation fires.
+const credentialRotationComponent = "credential-rotation"
+
+func (c *ReadyChecker) checkJobs(ctx context.Context, namespace string, selector client.MatchingLabels, results []workloadResult) ([]workloadResult, error) {
+	var list batchv1.JobList
+	if err := c.client.List(ctx, &list, client.InNamespace(namespace), selector); err != nil {
+		return nil, fmt.Errorf("failed to list jobs: %w", err)
+	}
+	for i := range list.Items {
+		if list.Items[i].Labels["app.kubernetes.io/component"] == credentialRotationComponent {
+			continue
+		}
+		ready, reason := jobReady(&list.Items[i])
+		results = append(results, workloadResult{kind: "Job", name: list.Items[i].Name, ready: ready, reason: reason})
+	}
+	return results, nil
+}
+
+func (c *ReadyChecker) checkPVCs(ctx context.Context, namespace string, selector client.MatchingLabels, results []workloadResult) ([]workloadResult, error) {
+	var list corev1.PersistentVolumeClaimList
+	if err := c.client.List(ctx, &list, client.InNamespace(namespace), selector); err != nil {
+		return nil, fmt.Errorf("failed to list persistentvolumeclaims: %w", err)
+	}
+	for i := range list.Items {
+		ready, reason := pvcReady(&list.Items[i])
+		results = append(results, workloadResult{kind: "PersistentVolumeClaim", name: list.Items[i].Name, ready: ready, reason: reason})
+	}
+	return results, nil
+}
+
+// checkCRDs lists CustomResourceDefinitions matching selector. CRDs are cluster-scoped, so
+// unlike the other kinds this isn't restricted to the tenant's namespace.
+func (c *ReadyChecker) checkCRDs(ctx context.Context, selector client.MatchingLabels, results []workloadResult) ([]workloadResult, error) {
+	var list apiextensionsv1.CustomResourceDefinitionList
+	if err := c.client.List(ctx, &list, selector); err != nil {
+		return nil, fmt.Errorf("failed to list customresourcedefinitions: %w", err)
+	}
+	for i := range list.Items {
+		ready, reason := crdReady(&list.Items[i])
+		results = append(results, workloadResult{kind: "CustomResourceDefinition", name: list.Items[i].Name, ready: ready, reason: reason})
+	}
+	return results, nil
+}
+
+// deploymentReady compares Status.UpdatedReplicas and Status.AvailableReplicas against
+// Spec.Replicas, and Status.ObservedGeneration against metadata.Generation, so a rollout
+// that's still in flight isn't reported ready just because old replicas are available.
+func deploymentReady(d *appsv1.Deployment) (bool, string) {
+	desired := int32(1)
+	if d.Spec.Replicas != nil {
+		desired = *d.Spec.Replicas
+	}
+	if d.Status.ObservedGeneration < d.Generation {
+		return false, "waiting for rollout to be observed"
+	}
+	if d.Status.UpdatedReplicas < desired {
+		return false, fmt.Sprintf("%d/%d replicas updated", d.Status.UpdatedReplicas, desired)
+	}
+	if d.Status.AvailableReplicas < desired {
+		return false, fmt.Sprintf("%d/%d replicas available", d.Status.AvailableReplicas, desired)
+	}
+	return true, fmt.Sprintf("%d/%d replicas available", d.Status.AvailableReplicas, desired)
+}
+
+// statefulSetReady requires every replica to be ready, and is partition-aware: when a
+// RollingUpdate partition is set, only replicas at or above the partition need to have
+// rolled to the current revision for the StatefulSet to count as ready.
+func statefulSetReady(s *appsv1.StatefulSet) (bool, string) {
+	desired := int32(1)
+	if s.Spec.Replicas != nil {
+		desired = *s.Spec.Replicas
+	}
+	if s.Status.ObservedGeneration < s.Generation {
+		return false, "waiting for rollout to be observed"
+	}
+	if s.Status.ReadyReplicas < desired {
+		return false, fmt.Sprintf("%d/%d replicas ready", s.Status.ReadyReplicas, desired)
+	}
+
+	var partition int32
+	if s.Spec.UpdateStrategy.Type == appsv1.RollingUpdateStatefulSetStrategyType &&
+		s.Spec.UpdateStrategy.RollingUpdate != nil &&
+		s.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
+		partition = *s.Spec.UpdateStrategy.RollingUpdate.Partition
+	}
+	if updateNeeded := desired - partition; updateNeeded > 0 && s.Status.UpdatedReplicas < updateNeeded {
+		return false, fmt.Sprintf("%d/%d replicas above partition %d updated", s.Status.UpdatedReplicas, updateNeeded, partition)
+	}
+	return true, fmt.Sprintf("%d/%d replicas ready", s.Status.ReadyReplicas, desired)
+}
+
+// daemonSetReady requires every scheduled pod to be ready.
+func daemonSetReady(d *appsv1.DaemonSet) (bool, string) {
+	if d.Status.NumberReady < d.Status.DesiredNumberScheduled {
+		return false, fmt.Sprintf("%d/%d ready", d.Status.NumberReady, d.Status.DesiredNumberScheduled)
+	}
+	return true, fmt.Sprintf("%d/%d ready", d.Status.NumberReady, d.Status.DesiredNumberScheduled)
+}
+
+// jobReady treats a terminal JobFailed condition as not ready regardless of completion
+// count, and otherwise requires Succeeded to reach Completions.
+func jobReady(j *batchv1.Job) (bool, string) {
+	for _, cond := range j.Status.Conditions {
+		if cond.Type == batchv1.JobFailed && cond.Status == corev1.ConditionTrue {
+			return false, fmt.Sprintf("job failed: %s", cond.Reason)
+		}
+	}
+
+	completions := int32(1)
+	if j.Spec.Completions != nil {
+		completions = *j.Spec.Completions
+	}
+	if j.Status.Succeeded < completions {
+		return false, fmt.Sprintf("%d/%d completions succeeded", j.Status.Succeeded, completions)
+	}
+	return true, fmt.Sprintf("%d/%d completions succeeded", j.Status.Succeeded, completions)
+}
+
+// pvcReady requires the claim to be Bound.
+func pvcReady(p *corev1.PersistentVolumeClaim) (bool, string) {
+	if p.Status.Phase != corev1.ClaimBound {
+		return false, fmt.Sprintf("phase is %s", p.Status.Phase)
+	}
+	return true, "bound"
+}
+
+// crdReady requires both the Established and NamesAccepted conditions to be True.
+func crdReady(c *apiextensionsv1.CustomResourceDefinition) (bool, string) {
+	established, namesAccepted := false, false
+	for _, cond := range c.Status.Conditions {
+		switch cond.Type {
+		case apiextensionsv1.Established:
+			established = cond.Status == apiextensionsv1.ConditionTrue
+		case apiextensionsv1.NamesAccepted:
+			namesAccepted = cond.Status == apiextensionsv1.ConditionTrue
+		}
+	}
+	if !established || !namesAccepted {
+		return false, "not established"
+	}
+	return true, "established"
+}
@@ -0,0 +1,81 @@
+// Package job runs a periodic background sync across every Tenant, correcting drift in
+// child resources that the event-driven reconciler never notices because nothing bumped the
+// Tenant's Generation (a CronJob deleted out-of-band, a hand-edited Ingress, a resized PVC).
+package job
+
+import (
+	"context"
+	"time"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// SyncFunc re-reconciles one targeted aspect of a tenant (health, backups, discovery, ...)
+// outside of the normal event-driven reconcile loop. A SyncFunc should be idempotent and
+// cheap to run unconditionally, since it is invoked for every Tenant on every tick.
+type SyncFunc func(ctx context.Context, tenant *tenantv1alpha1.Tenant) error
+
+type namedSync struct {
+	name string
+	fn   SyncFunc
+}
+
+// Scheduler periodically lists every Tenant and runs each registered SyncFunc against it.
+// It implements sigs.k8s.io/controller-runtime/pkg/manager.Runnable, so it runs as a
+// manager-managed goroutine alongside the reconciler rather than one the caller has to
+// start and stop by hand.
+type Scheduler struct {
+	client   client.Client
+	interval time.Duration
+	syncs    []namedSync
+}
+
+// NewScheduler creates a Scheduler that lists Tenants through c and runs its registered
+// syncs every interval.
+func NewScheduler(c client.Client, interval time.Duration) *Scheduler {
+	return &Scheduler{client: c, interval: interval}
+}
+
+// Register adds a named SyncFunc to run against every tenant on each tick. name is used
+// only for logging when a sync fails.
+func (s *Scheduler) Register(name string, fn SyncFunc) {
+	s.syncs = append(s.syncs, namedSync{name: name, fn: fn})
+}
+
+// Start runs the sync loop until ctx is canceled.
+func (s *Scheduler) Start(ctx context.Context) error {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			s.syncAll(ctx)
+		}
+	}
+}
+
+// syncAll lists every Tenant and runs every registered sync against it, logging but not
+// aborting on a per-tenant or per-sync failure so one broken tenant can't block the rest.
+func (s *Scheduler) syncAll(ctx context.Context) {
+	log := log.FromContext(ctx)
+
+	var tenants tenantv1alpha1.TenantList
+	if err := s.client.List(ctx, &tenants); err != nil {
+		log.Error(err, "background sync: failed to list tenants")
+		return
+	}
+
+	for i := range tenants.Items {
+		tenant := &tenants.Items[i]
+		for _, sync := range s.syncs {
+			if err := sync.fn(ctx, tenant); err != nil {
+				log.Error(err, "background sync failed", "sync", sync.name, "tenant", tenant.Name)
+			}
+		}
+	}
+}
@@ -0,0 +1,110 @@
+// Package jobs implements an in-process provisioning job queue so tenant creation can be
+// handed off to a worker instead of blocking the HTTP request.
+package jobs
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// Status is the lifecycle state of a ProvisioningJob.
+type Status string
+
+const (
+	StatusQueued    Status = "Queued"
+	StatusRunning   Status = "Running"
+	StatusSucceeded Status = "Succeeded"
+	StatusFailed    Status = "Failed"
+)
+
+// Event records a single step of a job's progress, e.g. ValidatingSpec or WaitingForNamespace.
+type Event struct {
+	Step      string    `json:"step"`
+	Message   string    `json:"message"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// ProvisioningJob tracks the state of an asynchronous tenant provisioning request.
+type ProvisioningJob struct {
+	ID         string      `json:"id"`
+	TenantName string      `json:"tenantName"`
+	Status     Status      `json:"status"`
+	Events     []Event     `json:"events"`
+	Error      string      `json:"error,omitempty"`
+	CreatedAt  time.Time   `json:"createdAt"`
+	UpdatedAt  time.Time   `json:"updatedAt"`
+	Payload    interface{} `json:"-"`
+}
+
+// Store is the persistence interface for ProvisioningJobs, kept narrow so a Redis or
+// Postgres-backed implementation can stand in for the in-memory default later.
+//
+// Get and the pointer passed to Update are owned by the caller the moment the call
+// returns: a Store must not keep a reference a later caller could observe being mutated,
+// since the worker pool keeps mutating its own copy of a running job between Update calls
+// while HTTP handlers call Get concurrently.
+type Store interface {
+	Create(job *ProvisioningJob) error
+	Get(id string) (*ProvisioningJob, bool)
+	Update(job *ProvisioningJob) error
+}
+
+// MemoryStore is a process-local Store backed by a map. It is the default backend and is
+// safe for concurrent use by the worker pool and HTTP handlers: every Create/Update stores
+// a snapshot copy of the job, so a caller mutating the *ProvisioningJob it passed in, or
+// reading the one Get returned, never races another caller doing the same.
+type MemoryStore struct {
+	mu   sync.RWMutex
+	jobs map[string]*ProvisioningJob
+}
+
+// NewMemoryStore creates an empty in-memory job store.
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{jobs: make(map[string]*ProvisioningJob)}
+}
+
+func (s *MemoryStore) Create(job *ProvisioningJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.jobs[job.ID] = job.clone()
+	return nil
+}
+
+func (s *MemoryStore) Get(id string) (*ProvisioningJob, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	job, ok := s.jobs[id]
+	if !ok {
+		return nil, false
+	}
+	return job.clone(), true
+}
+
+func (s *MemoryStore) Update(job *ProvisioningJob) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.jobs[job.ID]; !ok {
+		return fmt.Errorf("job %s not found", job.ID)
+	}
+	s.jobs[job.ID] = job.clone()
+	return nil
+}
+
+// clone returns a copy of j safe to hand to a caller that may keep mutating its own job
+// pointer, or to store independently of one a caller already holds.
+func (j *ProvisioningJob) clone() *ProvisioningJob {
+	cp := *j
+	cp.Events = append([]Event(nil), j.Events...)
+	return &cp
+}
+
+var jobCounter uint64
+
+// newJobID returns a process-unique job identifier. It avoids pulling in a UUID dependency
+// since job IDs only need to be unique within this orchestrator's lifetime.
+func newJobID() string {
+	n := atomic.AddUint64(&jobCounter, 1)
+	return fmt.Sprintf("job-%d-%d", time.Now().UnixNano(), n)
+}
@@ -0,0 +1,137 @@
+package jobs
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	jobDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name: "tenant_provisioning_job_duration_seconds",
+			Help: "Duration of tenant provisioning jobs by final status",
+		},
+		[]string{"status"},
+	)
+	jobsTotal = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "tenant_provisioning_jobs_total",
+			Help: "Total number of tenant provisioning jobs by final status",
+		},
+		[]string{"status"},
+	)
+)
+
+// ProcessFunc performs the actual provisioning work for a job. Step-level progress should
+// be reported through the EventReporter; a returned error marks the job failed.
+type ProcessFunc func(ctx context.Context, tenantName string, payload interface{}, report EventReporter) error
+
+// EventReporter lets a ProcessFunc append a step event to the job it is running for.
+type EventReporter func(step, message string)
+
+const maxAttempts = 3
+
+// Pool is a small worker pool that drains queued ProvisioningJobs and runs them through a
+// ProcessFunc, retrying transient failures with backoff before giving up.
+type Pool struct {
+	store   Store
+	queue   chan *ProvisioningJob
+	process ProcessFunc
+}
+
+// NewPool starts a pool of workers consuming from an internal queue. Jobs are persisted to
+// store before being queued so Get can observe them immediately as Queued.
+func NewPool(store Store, workers int, process ProcessFunc) *Pool {
+	p := &Pool{
+		store:   store,
+		queue:   make(chan *ProvisioningJob, 100),
+		process: process,
+	}
+	for i := 0; i < workers; i++ {
+		go p.worker()
+	}
+	return p
+}
+
+// Enqueue creates a new ProvisioningJob for tenantName and schedules it for processing.
+// payload is handed back to the ProcessFunc unchanged (e.g. the decoded create request).
+func (p *Pool) Enqueue(tenantName string, payload interface{}) *ProvisioningJob {
+	now := time.Now().UTC()
+	job := &ProvisioningJob{
+		ID:         newJobID(),
+		TenantName: tenantName,
+		Status:     StatusQueued,
+		Events: []Event{
+			{Step: "Queued", Message: "provisioning job queued", Timestamp: now},
+		},
+		CreatedAt: now,
+		UpdatedAt: now,
+		Payload:   payload,
+	}
+	p.store.Create(job)
+	snapshot := job.clone()
+	p.queue <- job
+	return snapshot
+}
+
+// Get returns the job with the given ID, if known.
+func (p *Pool) Get(id string) (*ProvisioningJob, bool) {
+	return p.store.Get(id)
+}
+
+func (p *Pool) worker() {
+	for job := range p.queue {
+		p.runJob(job)
+	}
+}
+
+func (p *Pool) runJob(job *ProvisioningJob) {
+	job.Status = StatusRunning
+	p.appendEvent(job, "Running", "worker picked up job")
+
+	start := time.Now()
+	ctx := context.Background()
+	report := func(step, message string) {
+		p.appendEvent(job, step, message)
+	}
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		err = p.process(ctx, job.TenantName, job.Payload, report)
+		if err == nil {
+			break
+		}
+		if attempt < maxAttempts {
+			backoff := time.Duration(attempt) * time.Second
+			p.appendEvent(job, "Retrying", err.Error())
+			log.Printf("⚠️ provisioning job %s failed (attempt %d/%d), retrying in %v: %v", job.ID, attempt, maxAttempts, backoff, err)
+			time.Sleep(backoff)
+		}
+	}
+
+	if err != nil {
+		job.Status = StatusFailed
+		job.Error = err.Error()
+		p.appendEvent(job, "Failed", err.Error())
+		jobDuration.WithLabelValues(string(StatusFailed)).Observe(time.Since(start).Seconds())
+		jobsTotal.WithLabelValues(string(StatusFailed)).Inc()
+		return
+	}
+
+	job.Status = StatusSucceeded
+	p.appendEvent(job, "Ready", "tenant provisioning complete")
+	jobDuration.WithLabelValues(string(StatusSucceeded)).Observe(time.Since(start).Seconds())
+	jobsTotal.WithLabelValues(string(StatusSucceeded)).Inc()
+}
+
+func (p *Pool) appendEvent(job *ProvisioningJob, step, message string) {
+	job.Events = append(job.Events, Event{Step: step, Message: message, Timestamp: time.Now().UTC()})
+	job.UpdatedAt = time.Now().UTC()
+	if err := p.store.Update(job); err != nil {
+		log.Printf("❌ failed to persist job %s update: %v", job.ID, err)
+	}
+}
@@ -0,0 +1,116 @@
+package jobs
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestPool_RetriesThenSucceeds(t *testing.T) {
+	store := NewMemoryStore()
+	var attempts int32
+
+	process := func(ctx context.Context, tenantName string, payload interface{}, report EventReporter) error {
+		n := atomic.AddInt32(&attempts, 1)
+		report("Processing", fmt.Sprintf("attempt %d", n))
+		if n < maxAttempts {
+			return fmt.Errorf("transient failure on attempt %d", n)
+		}
+		return nil
+	}
+
+	pool := NewPool(store, 1, process)
+	job := pool.Enqueue("acme", nil)
+
+	// Concurrent Get calls while the job is retrying/succeeding must only ever observe a
+	// complete, self-consistent Events slice - never one a concurrent append to the worker's
+	// copy is still writing into. Run this test with `go test -race` to prove it.
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-stop:
+				return
+			default:
+			}
+			if got, ok := pool.Get(job.ID); ok {
+				for _, ev := range got.Events {
+					if ev.Step == "" {
+						t.Errorf("observed a torn/zero-value Event in job %s: %+v", job.ID, ev)
+					}
+				}
+			}
+		}
+	}()
+
+	deadline := time.Now().Add(5 * time.Second)
+	var final *ProvisioningJob
+	for time.Now().Before(deadline) {
+		got, ok := pool.Get(job.ID)
+		if ok && (got.Status == StatusSucceeded || got.Status == StatusFailed) {
+			final = got
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	close(stop)
+	wg.Wait()
+
+	if final == nil {
+		t.Fatal("job did not reach a terminal status before the test deadline")
+	}
+	if final.Status != StatusSucceeded {
+		t.Fatalf("expected job to succeed after %d attempts, got status %s (error: %s)", maxAttempts, final.Status, final.Error)
+	}
+	if got := atomic.LoadInt32(&attempts); got != maxAttempts {
+		t.Errorf("expected exactly %d attempts, got %d", maxAttempts, got)
+	}
+
+	wantSteps := []string{"Queued", "Running", "Processing", "Retrying", "Processing", "Retrying", "Processing", "Ready"}
+	if len(final.Events) != len(wantSteps) {
+		t.Fatalf("expected %d events, got %d: %+v", len(wantSteps), len(final.Events), final.Events)
+	}
+	for i, step := range wantSteps {
+		if final.Events[i].Step != step {
+			t.Errorf("event %d: expected step %q, got %q", i, step, final.Events[i].Step)
+		}
+	}
+}
+
+func TestPool_FailsAfterMaxAttempts(t *testing.T) {
+	store := NewMemoryStore()
+
+	process := func(ctx context.Context, tenantName string, payload interface{}, report EventReporter) error {
+		return fmt.Errorf("permanent failure")
+	}
+
+	pool := NewPool(store, 1, process)
+	job := pool.Enqueue("acme", nil)
+
+	deadline := time.Now().Add(5 * time.Second)
+	var final *ProvisioningJob
+	for time.Now().Before(deadline) {
+		got, ok := pool.Get(job.ID)
+		if ok && (got.Status == StatusSucceeded || got.Status == StatusFailed) {
+			final = got
+			break
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+
+	if final == nil {
+		t.Fatal("job did not reach a terminal status before the test deadline")
+	}
+	if final.Status != StatusFailed {
+		t.Fatalf("expected job to fail after exhausting retries, got status %s", final.Status)
+	}
+	if final.Error == "" {
+		t.Error("expected a non-empty Error on a failed job")
+	}
+}
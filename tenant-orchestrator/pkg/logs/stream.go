@@ -0,0 +1,122 @@
+package logs
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Record is a single log line emitted for one pod/container belonging to a tenant.
+type Record struct {
+	Pod       string    `json:"pod"`
+	Container string    `json:"container"`
+	Timestamp time.Time `json:"timestamp"`
+	Line      string    `json:"line"`
+}
+
+// Options controls which pods/containers are streamed and how far back to read.
+type Options struct {
+	Service   string
+	Container string
+	Since     time.Duration
+	TailLines int64
+}
+
+// Streamer fans the logs of every pod belonging to a tenant into a single merged stream.
+type Streamer struct {
+	clientset kubernetes.Interface
+}
+
+// NewStreamer creates a Streamer backed by the given Kubernetes clientset.
+func NewStreamer(clientset kubernetes.Interface) *Streamer {
+	return &Streamer{clientset: clientset}
+}
+
+// Stream opens a follow=true log reader per matching pod/container and writes merged
+// NDJSON records to w until ctx is canceled or every pod stream ends.
+func (s *Streamer) Stream(ctx context.Context, w http.ResponseWriter, namespace string, pods []corev1.Pod, opts Options) error {
+	flusher, _ := w.(http.Flusher)
+
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	records := make(chan Record, 100)
+	var wg sync.WaitGroup
+
+	for _, pod := range pods {
+		if opts.Service != "" && pod.Labels["app"] != opts.Service {
+			continue
+		}
+		for _, c := range pod.Spec.Containers {
+			if opts.Container != "" && c.Name != opts.Container {
+				continue
+			}
+			wg.Add(1)
+			go s.streamPod(streamCtx, &wg, records, namespace, pod.Name, c.Name, opts)
+		}
+	}
+
+	go func() {
+		wg.Wait()
+		close(records)
+	}()
+
+	enc := json.NewEncoder(w)
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case rec, ok := <-records:
+			if !ok {
+				return nil
+			}
+			if err := enc.Encode(rec); err != nil {
+				return err
+			}
+			if flusher != nil {
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// streamPod opens a single pod/container log stream and pushes each scanned line to out
+// until the pod closes the stream or ctx is canceled.
+func (s *Streamer) streamPod(ctx context.Context, wg *sync.WaitGroup, out chan<- Record, namespace, pod, container string, opts Options) {
+	defer wg.Done()
+
+	logOpts := &corev1.PodLogOptions{
+		Container: container,
+		Follow:    true,
+	}
+	if opts.TailLines > 0 {
+		logOpts.TailLines = &opts.TailLines
+	}
+	if opts.Since > 0 {
+		sinceSeconds := int64(opts.Since.Seconds())
+		logOpts.SinceSeconds = &sinceSeconds
+	}
+
+	readCloser, err := s.clientset.CoreV1().Pods(namespace).GetLogs(pod, logOpts).Stream(ctx)
+	if err != nil {
+		out <- Record{Pod: pod, Container: container, Timestamp: time.Now().UTC(), Line: fmt.Sprintf("error opening log stream: %v", err)}
+		return
+	}
+	defer readCloser.Close()
+
+	scanner := bufio.NewScanner(readCloser)
+	for scanner.Scan() {
+		select {
+		case <-ctx.Done():
+			return
+		case out <- Record{Pod: pod, Container: container, Timestamp: time.Now().UTC(), Line: scanner.Text()}:
+		}
+	}
+}
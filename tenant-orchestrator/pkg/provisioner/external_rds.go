@@ -0,0 +1,108 @@
+package provisioner
+
+import (
+	"context"
+	"fmt"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/credentials"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// RDSClient is the subset of the AWS RDS API ExternalRDSProvisioner needs. It is satisfied
+// by *rds.Client from the AWS SDK; tests can supply a fake.
+type RDSClient interface {
+	CreateInstance(ctx context.Context, identifier string, tenant *tenantv1alpha1.Tenant) (endpoint string, err error)
+	ModifyInstanceVersion(ctx context.Context, identifier, newVersion string) error
+	DeleteInstance(ctx context.Context, identifier string) error
+	DescribeInstance(ctx context.Context, identifier string) (Status, error)
+}
+
+// ExternalRDSProvisioner points a tenant at a dedicated AWS RDS instance instead of an
+// in-cluster StatefulSet. It still creates the tenant's credentials Secret in-cluster so
+// workloads consume RDS the same way they consume the in-cluster database.
+type ExternalRDSProvisioner struct {
+	client client.Client
+	rds    RDSClient
+}
+
+// NewExternalRDSProvisioner creates an ExternalRDSProvisioner that manages instances
+// through rds and mirrors credentials into the cluster through c.
+func NewExternalRDSProvisioner(c client.Client, rds RDSClient) *ExternalRDSProvisioner {
+	return &ExternalRDSProvisioner{client: c, rds: rds}
+}
+
+// Provision creates a dedicated RDS instance for tenant and mirrors its connection details
+// into the tenant's credentials Secret so consuming Deployments need no per-provisioner
+// configuration. The ProvisionID is the RDS instance identifier.
+func (p *ExternalRDSProvisioner) Provision(ctx context.Context, tenant *tenantv1alpha1.Tenant) (string, error) {
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+	identifier := fmt.Sprintf("tenant-%s-db", tenant.Name)
+
+	// Provision must be safe to call again with the same tenant (see the Provisioner
+	// interface doc), so check for an already-created instance before asking RDS to make
+	// another one.
+	status, err := p.rds.DescribeInstance(ctx, identifier)
+	exists := err == nil && status.Phase != "NotFound"
+
+	var endpoint string
+	if exists {
+		endpoint = status.ConnectionURL
+	} else {
+		endpoint, err = p.rds.CreateInstance(ctx, identifier, tenant)
+		if err != nil {
+			return "", fmt.Errorf("failed to create RDS instance %s: %w", identifier, err)
+		}
+	}
+
+	password, err := credentials.Generate()
+	if err != nil {
+		return "", err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-db-credentials", tenant.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name": tenant.Name,
+				"app.kubernetes.io/managed-by": "tenant-orchestrator",
+				"app.kubernetes.io/part-of": "tenant-infrastructure",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"username": []byte(fmt.Sprintf("tenant_%s", tenant.Name)),
+			"password": []byte(password),
+			"database": []byte(fmt.Sprintf("tenant_%s_db", tenant.Name)),
+			"host":     []byte(endpoint),
+		},
+	}
+	if err := p.client.Create(ctx, secret); err != nil && !errors.IsAlreadyExists(err) {
+		return "", err
+	}
+
+	tenant.Status.DatabaseStatus.ConnectionURL = fmt.Sprintf("%s:5432/%s", endpoint, fmt.Sprintf("tenant_%s_db", tenant.Name))
+
+	return identifier, nil
+}
+
+// Update applies a Postgres engine version upgrade to the RDS instance identified by
+// provisionID.
+func (p *ExternalRDSProvisioner) Update(ctx context.Context, provisionID, newVersion string) error {
+	return p.rds.ModifyInstanceVersion(ctx, provisionID, newVersion)
+}
+
+// Deprovision deletes the RDS instance identified by provisionID. The tenant's credentials
+// Secret is left for the caller's namespace deletion to clean up.
+func (p *ExternalRDSProvisioner) Deprovision(ctx context.Context, provisionID string) error {
+	return p.rds.DeleteInstance(ctx, provisionID)
+}
+
+// Status reports the RDS instance's current lifecycle state.
+func (p *ExternalRDSProvisioner) Status(ctx context.Context, provisionID string) (Status, error) {
+	return p.rds.DescribeInstance(ctx, provisionID)
+}
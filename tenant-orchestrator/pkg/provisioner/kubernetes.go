@@ -0,0 +1,519 @@
+package provisioner
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/credentials"
+)
+
+// fieldOwner identifies this controller's field manager on server-side-applied objects.
+const fieldOwner = client.FieldOwner("tenant-orchestrator")
+
+// KubernetesProvisioner runs a tenant's Postgres as an in-cluster Patroni HA StatefulSet.
+// It is the default backend, keyed by the Kubernetes constant, and is what every tenant
+// used before the Provisioner abstraction existed.
+type KubernetesProvisioner struct {
+	client                client.Client
+	scheme                *runtime.Scheme
+	enableOwnerReferences bool
+}
+
+// NewKubernetesProvisioner creates a KubernetesProvisioner backed by c. When
+// enableOwnerReferences is true, tenant is set as the controller owner of the Services,
+// credentials Secret, and StatefulSet it creates, so deleting the Tenant cascades via
+// Kubernetes garbage collection; the Patroni ServiceAccount, Role, and RoleBinding get no
+// owner reference. When false, the caller is relying on its own cleanup path instead.
+func NewKubernetesProvisioner(c client.Client, scheme *runtime.Scheme, enableOwnerReferences bool) *KubernetesProvisioner {
+	return &KubernetesProvisioner{client: c, scheme: scheme, enableOwnerReferences: enableOwnerReferences}
+}
+
+// apply server-side-applies obj so a later change to the owning Tenant (e.g.
+// Spec.Database.Version or Spec.Resources.CPU.Limit) propagates to it, instead of being
+// silently ignored the way Create-if-not-exists was.
+func (p *KubernetesProvisioner) apply(ctx context.Context, obj client.Object) error {
+	gvks, _, err := p.scheme.ObjectKinds(obj)
+	if err != nil || len(gvks) == 0 {
+		return fmt.Errorf("failed to look up GroupVersionKind for %T: %w", obj, err)
+	}
+	obj.GetObjectKind().SetGroupVersionKind(gvks[0])
+	return p.client.Patch(ctx, obj, client.Apply, fieldOwner, client.ForceOwnership)
+}
+
+// setOwnerReference makes tenant the controller owner of obj when enableOwnerReferences is
+// set.
+func (p *KubernetesProvisioner) setOwnerReference(tenant *tenantv1alpha1.Tenant, obj client.Object) error {
+	if !p.enableOwnerReferences {
+		return nil
+	}
+	return controllerutil.SetControllerReference(tenant, obj, p.scheme)
+}
+
+// Provision creates the primary/replica/headless Services, the Patroni RBAC, the
+// credentials Secret, and the Patroni StatefulSet for tenant, then returns a ProvisionID of
+// the form "<namespace>/<statefulset-name>" so later calls can address it without tenant.
+func (p *KubernetesProvisioner) Provision(ctx context.Context, tenant *tenantv1alpha1.Tenant) (string, error) {
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+
+	// Read/write service: Patroni promotes one pod to "spilo-role=master" and keeps that
+	// label current, so this always routes writes to the current leader.
+	primaryService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-db-primary", tenant.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name": tenant.Name,
+				"app.kubernetes.io/managed-by": "tenant-orchestrator",
+				"app.kubernetes.io/part-of": "tenant-infrastructure",
+				"app.kubernetes.io/component": "database",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "postgres", "tenant": tenant.Name, "spilo-role": "master"},
+			Ports: []corev1.ServicePort{
+				{
+					Port:       5432,
+					TargetPort: intstr.FromInt(5432),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+
+	if err := p.setOwnerReference(tenant, primaryService); err != nil {
+		return "", err
+	}
+	if err := p.apply(ctx, primaryService); err != nil {
+		return "", err
+	}
+
+	// Read-only service: load-balances across standbys, so reporting/analytics traffic
+	// doesn't compete with the primary for connections.
+	replicaService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-db-replicas", tenant.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name": tenant.Name,
+				"app.kubernetes.io/managed-by": "tenant-orchestrator",
+				"app.kubernetes.io/part-of": "tenant-infrastructure",
+				"app.kubernetes.io/component": "database",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{"app": "postgres", "tenant": tenant.Name, "spilo-role": "replica"},
+			Ports: []corev1.ServicePort{
+				{
+					Port:       5432,
+					TargetPort: intstr.FromInt(5432),
+					Protocol:   corev1.ProtocolTCP,
+				},
+			},
+		},
+	}
+
+	if err := p.setOwnerReference(tenant, replicaService); err != nil {
+		return "", err
+	}
+	if err := p.apply(ctx, replicaService); err != nil {
+		return "", err
+	}
+
+	// Headless service so Patroni cluster members can find each other by pod DNS, and so
+	// Patroni's REST API (used for health checks and its Kubernetes DCS) is reachable.
+	dbHeadlessService := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-db", tenant.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name": tenant.Name,
+				"app.kubernetes.io/managed-by": "tenant-orchestrator",
+				"app.kubernetes.io/part-of": "tenant-infrastructure",
+				"app.kubernetes.io/component": "database",
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			ClusterIP: corev1.ClusterIPNone,
+			Selector:  map[string]string{"app": "postgres", "tenant": tenant.Name},
+			Ports: []corev1.ServicePort{
+				{Name: "postgresql", Port: 5432, TargetPort: intstr.FromInt(5432)},
+				{Name: "patroni-api", Port: 8008, TargetPort: intstr.FromInt(8008)},
+			},
+		},
+	}
+
+	if err := p.setOwnerReference(tenant, dbHeadlessService); err != nil {
+		return "", err
+	}
+	if err := p.apply(ctx, dbHeadlessService); err != nil {
+		return "", err
+	}
+
+	if err := p.reconcileDatabaseRBAC(ctx, tenant); err != nil {
+		return "", err
+	}
+
+	password, err := credentials.Generate()
+	if err != nil {
+		return "", fmt.Errorf("failed to generate database credentials: %w", err)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-db-credentials", tenant.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name": tenant.Name,
+				"app.kubernetes.io/managed-by": "tenant-orchestrator",
+				"app.kubernetes.io/part-of": "tenant-infrastructure",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"username": []byte(fmt.Sprintf("tenant_%s", tenant.Name)),
+			"password": []byte(password),
+			"database": []byte(fmt.Sprintf("tenant_%s_db", tenant.Name)),
+		},
+	}
+
+	if err := p.setOwnerReference(tenant, secret); err != nil {
+		return "", err
+	}
+	// Create-if-absent, not apply: the Secret holds the password Postgres was actually
+	// initialized with, so a later Provision call (e.g. the steady-state requeue) must leave
+	// it untouched rather than force-applying a freshly generated password that was never
+	// applied to the database. Rotating the live password is Rotator's job, not Provision's.
+	if err := p.client.Create(ctx, secret); err != nil && !errors.IsAlreadyExists(err) {
+		return "", fmt.Errorf("failed to create credentials secret %s/%s: %w", namespace, secret.Name, err)
+	}
+
+	statefulSet := p.patroniStatefulSet(tenant)
+	if err := p.setOwnerReference(tenant, statefulSet); err != nil {
+		return "", err
+	}
+	if err := p.apply(ctx, statefulSet); err != nil {
+		return "", err
+	}
+
+	tenant.Status.DatabaseStatus.ConnectionURL = fmt.Sprintf("%s-db-primary.%s.svc.cluster.local:5432/%s", tenant.Name, namespace, fmt.Sprintf("tenant_%s_db", tenant.Name))
+	tenant.Status.DatabaseStatus.ReplicaURL = fmt.Sprintf("%s-db-replicas.%s.svc.cluster.local:5432/%s", tenant.Name, namespace, fmt.Sprintf("tenant_%s_db", tenant.Name))
+
+	if err := p.refreshDatabaseTopology(ctx, tenant); err != nil {
+		log.FromContext(ctx).Info("Unable to determine current Patroni topology yet", "error", err.Error())
+	}
+
+	return fmt.Sprintf("%s/%s", namespace, statefulSet.Name), nil
+}
+
+// Update patches the Patroni StatefulSet's Postgres container to newVersion in place,
+// instead of the caller deleting and recreating the StatefulSet.
+func (p *KubernetesProvisioner) Update(ctx context.Context, provisionID, newVersion string) error {
+	namespace, name, err := splitProvisionID(provisionID)
+	if err != nil {
+		return err
+	}
+
+	var sts appsv1.StatefulSet
+	if err := p.client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &sts); err != nil {
+		return fmt.Errorf("failed to load StatefulSet %s/%s: %w", namespace, name, err)
+	}
+
+	for i := range sts.Spec.Template.Spec.Containers {
+		if sts.Spec.Template.Spec.Containers[i].Name == "postgres" {
+			sts.Spec.Template.Spec.Containers[i].Image = fmt.Sprintf("ghcr.io/zalando/spilo-%s:3.2-p1", newVersion)
+		}
+	}
+
+	return p.client.Update(ctx, &sts)
+}
+
+// Deprovision deletes everything Provision created for provisionID. It is safe to call on
+// a tenant that was never fully provisioned; missing resources are ignored.
+func (p *KubernetesProvisioner) Deprovision(ctx context.Context, provisionID string) error {
+	namespace, name, err := splitProvisionID(provisionID)
+	if err != nil {
+		return err
+	}
+	tenantName := strings.TrimSuffix(name, "-db")
+	rbacName := fmt.Sprintf("%s-patroni", tenantName)
+
+	objs := []client.Object{
+		&appsv1.StatefulSet{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-primary", name), Namespace: namespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-replicas", name), Namespace: namespace}},
+		&corev1.Service{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("%s-credentials", name), Namespace: namespace}},
+		&rbacv1.RoleBinding{ObjectMeta: metav1.ObjectMeta{Name: rbacName, Namespace: namespace}},
+		&rbacv1.Role{ObjectMeta: metav1.ObjectMeta{Name: rbacName, Namespace: namespace}},
+		&corev1.ServiceAccount{ObjectMeta: metav1.ObjectMeta{Name: rbacName, Namespace: namespace}},
+	}
+
+	for _, obj := range objs {
+		if err := p.client.Delete(ctx, obj); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete %s: %w", obj.GetName(), err)
+		}
+	}
+	return nil
+}
+
+// Status reports the Patroni StatefulSet's rollout state for provisionID.
+func (p *KubernetesProvisioner) Status(ctx context.Context, provisionID string) (Status, error) {
+	namespace, name, err := splitProvisionID(provisionID)
+	if err != nil {
+		return Status{}, err
+	}
+
+	var sts appsv1.StatefulSet
+	if err := p.client.Get(ctx, types.NamespacedName{Name: name, Namespace: namespace}, &sts); err != nil {
+		if errors.IsNotFound(err) {
+			return Status{Phase: "NotFound"}, nil
+		}
+		return Status{}, err
+	}
+
+	phase := "Provisioning"
+	if sts.Spec.Replicas != nil && sts.Status.ReadyReplicas == *sts.Spec.Replicas && sts.Status.ReadyReplicas > 0 {
+		phase = "Ready"
+	}
+
+	return Status{
+		Phase:         phase,
+		ConnectionURL: fmt.Sprintf("%s-primary.%s.svc.cluster.local:5432", name, namespace),
+	}, nil
+}
+
+// splitProvisionID unpacks a kubernetes ProvisionID back into its namespace and
+// StatefulSet name.
+func splitProvisionID(provisionID string) (namespace, name string, err error) {
+	parts := strings.SplitN(provisionID, "/", 2)
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("invalid kubernetes provision id %q, want <namespace>/<statefulset-name>", provisionID)
+	}
+	return parts[0], parts[1], nil
+}
+
+// patroniReplicaCount returns the HA cluster size for a tier. Lower tiers get a single
+// node rather than paying for standbys they won't use.
+func patroniReplicaCount(tier string) int32 {
+	switch tier {
+	case "enterprise", "premium":
+		return 3
+	default:
+		return 1
+	}
+}
+
+// reconcileDatabaseRBAC grants the Patroni pods permission to manage the Endpoints and
+// Pod labels it uses as its Kubernetes DCS and leader indicator.
+func (p *KubernetesProvisioner) reconcileDatabaseRBAC(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+	name := fmt.Sprintf("%s-patroni", tenant.Name)
+
+	sa := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+	}
+	if err := p.client.Create(ctx, sa); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	role := &rbacv1.Role{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Rules: []rbacv1.PolicyRule{
+			{APIGroups: []string{""}, Resources: []string{"endpoints", "configmaps"}, Verbs: []string{"get", "list", "create", "update", "patch", "watch"}},
+			{APIGroups: []string{""}, Resources: []string{"pods"}, Verbs: []string{"get", "list", "patch", "update", "watch"}},
+		},
+	}
+	if err := p.client.Create(ctx, role); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	binding := &rbacv1.RoleBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Subjects:   []rbacv1.Subject{{Kind: "ServiceAccount", Name: name, Namespace: namespace}},
+		RoleRef:    rbacv1.RoleRef{APIGroup: "rbac.authorization.k8s.io", Kind: "Role", Name: name},
+	}
+	if err := p.client.Create(ctx, binding); err != nil && !errors.IsAlreadyExists(err) {
+		return err
+	}
+
+	return nil
+}
+
+// refreshDatabaseTopology records which pod currently holds the Patroni leader lease, the
+// configured synchronous standby, and the replication lag reported on the leader's pod
+// annotations, so Tenant.Status.DatabaseStatus reflects the live cluster rather than just
+// "the StatefulSet exists".
+func (p *KubernetesProvisioner) refreshDatabaseTopology(ctx context.Context, tenant *tenantv1alpha1.Tenant) error {
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+
+	var pods corev1.PodList
+	if err := p.client.List(ctx, &pods, client.InNamespace(namespace), client.MatchingLabels{
+		"app":    "postgres",
+		"tenant": tenant.Name,
+	}); err != nil {
+		return err
+	}
+
+	for _, pod := range pods.Items {
+		if pod.Labels["spilo-role"] != "master" {
+			continue
+		}
+		tenant.Status.DatabaseStatus.Leader = pod.Name
+		tenant.Status.DatabaseStatus.SynchronousStandby = pod.Annotations["patroni.rezenkai.com/sync-standby"]
+		tenant.Status.DatabaseStatus.ReplicaLag = pod.Annotations["patroni.rezenkai.com/replica-lag"]
+		return nil
+	}
+
+	return fmt.Errorf("no pod currently holds the spilo-role=master label")
+}
+
+// SecretKeyRef points at a key in the tenant's generated database credentials Secret.
+func SecretKeyRef(tenant *tenantv1alpha1.Tenant, key string) *corev1.EnvVarSource {
+	return &corev1.EnvVarSource{
+		SecretKeyRef: &corev1.SecretKeySelector{
+			LocalObjectReference: corev1.LocalObjectReference{Name: fmt.Sprintf("%s-db-credentials", tenant.Name)},
+			Key:                  key,
+		},
+	}
+}
+
+// patroniStatefulSet builds an N-pod StatefulSet where each pod runs Postgres with a
+// Patroni sidecar (the Spilo image bundles both). Patroni elects the leader through a
+// Kubernetes endpoints/configmap lease scoped to the tenant namespace and maintains the
+// "spilo-role" label the primary/replica Services select on.
+func (p *KubernetesProvisioner) patroniStatefulSet(tenant *tenantv1alpha1.Tenant) *appsv1.StatefulSet {
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+	scope := fmt.Sprintf("%s-db", tenant.Name)
+
+	replicas := tenant.Spec.Database.Replicas
+	if replicas == 0 {
+		replicas = patroniReplicaCount(tenant.Spec.Tier)
+	}
+
+	labels := map[string]string{
+		"app":    "postgres",
+		"tenant": tenant.Name,
+		"tenant.rezenkai.com/name": tenant.Name,
+		"app.kubernetes.io/managed-by": "tenant-orchestrator",
+		"app.kubernetes.io/part-of": "tenant-infrastructure",
+		"app.kubernetes.io/component": "database",
+	}
+
+	env := []corev1.EnvVar{
+		{Name: "SCOPE", Value: scope},
+		{Name: "PGUSER_SUPERUSER", Value: "postgres"},
+		{Name: "PGPASSWORD_SUPERUSER", ValueFrom: SecretKeyRef(tenant, "password")},
+		{Name: "PATRONI_KUBERNETES_NAMESPACE", Value: namespace},
+		{Name: "PATRONI_KUBERNETES_LABELS", Value: fmt.Sprintf(`{"app":"postgres","tenant":"%s"}`, tenant.Name)},
+		{Name: "PATRONI_KUBERNETES_SCOPE_LABEL", Value: "app"},
+		{Name: "PATRONI_KUBERNETES_ROLE_LABEL", Value: "spilo-role"},
+		{Name: "PATRONI_RESTAPI_LISTEN", Value: "0.0.0.0:8008"},
+		{Name: "PATRONI_POSTGRESQL_LISTEN", Value: "0.0.0.0:5432"},
+		{
+			Name: "POD_IP",
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{FieldPath: "status.podIP"},
+			},
+		},
+		{Name: "POD_NAMESPACE", Value: namespace},
+	}
+
+	if tenant.Spec.Database.Backup.Enabled && tenant.Spec.Database.Backup.WALS3Bucket != "" {
+		env = append(env,
+			corev1.EnvVar{Name: "WAL_S3_BUCKET", Value: tenant.Spec.Database.Backup.WALS3Bucket},
+			corev1.EnvVar{Name: "USE_WALG_BACKUP", Value: "true"},
+			corev1.EnvVar{Name: "USE_WALG_RESTORE", Value: "true"},
+		)
+	}
+
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      scope,
+			Namespace: namespace,
+			Labels:    labels,
+		},
+		Spec: appsv1.StatefulSetSpec{
+			Replicas:    &replicas,
+			ServiceName: fmt.Sprintf("%s-db", tenant.Name),
+			Selector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{
+					"app":    "postgres",
+					"tenant": tenant.Name,
+				},
+			},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: fmt.Sprintf("%s-patroni", tenant.Name),
+					Containers: []corev1.Container{
+						{
+							Name:  "postgres",
+							Image: fmt.Sprintf("ghcr.io/zalando/spilo-%s:3.2-p1", tenant.Spec.Database.Version),
+							Env:   env,
+							Ports: []corev1.ContainerPort{
+								{ContainerPort: 5432, Name: "postgresql"},
+								{ContainerPort: 8008, Name: "patroni-api"},
+							},
+							VolumeMounts: []corev1.VolumeMount{
+								{
+									Name:      "postgres-storage",
+									MountPath: "/home/postgres/pgdata",
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{Path: "/readiness", Port: intstr.FromInt(8008)},
+								},
+								InitialDelaySeconds: 10,
+								PeriodSeconds:       10,
+							},
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("100m"),
+									corev1.ResourceMemory: resource.MustParse("256Mi"),
+								},
+								Limits: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse("1"),
+									corev1.ResourceMemory: resource.MustParse("1Gi"),
+								},
+							},
+						},
+					},
+				},
+			},
+			VolumeClaimTemplates: []corev1.PersistentVolumeClaim{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "postgres-storage",
+					},
+					Spec: corev1.PersistentVolumeClaimSpec{
+						AccessModes: []corev1.PersistentVolumeAccessMode{
+							corev1.ReadWriteOnce,
+						},
+						// FIXED: Use VolumeResourceRequirements instead of ResourceRequirements
+						Resources: corev1.VolumeResourceRequirements{
+							Requests: corev1.ResourceList{
+								corev1.ResourceStorage: resource.MustParse(tenant.Spec.Resources.Storage.Size),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
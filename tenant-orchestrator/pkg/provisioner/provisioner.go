@@ -0,0 +1,61 @@
+// Package provisioner abstracts the runtime a tenant's stateful workload lands on behind a
+// single interface, so the reconciler can target an in-cluster StatefulSet, an external
+// managed database, or a pre-existing shared cluster without branching on each call site.
+package provisioner
+
+import (
+	"context"
+	"fmt"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+)
+
+// Names registered tenants reference via Spec.Provisioner.
+const (
+	Kubernetes    = "kubernetes"
+	ExternalRDS   = "external-rds"
+	SharedCluster = "shared-cluster"
+)
+
+// Status reports the current state of a tenant's provisioned backend.
+type Status struct {
+	Phase         string
+	ConnectionURL string
+	Message       string
+}
+
+// Provisioner manages the lifecycle of a tenant's backend on one runtime. ProvisionID is
+// opaque to the caller and is persisted on Tenant.Status so later reconciles route back to
+// the same backend without re-deriving it.
+type Provisioner interface {
+	// Provision creates the backend for tenant and returns its ProvisionID. It must be
+	// safe to call again with the same tenant (e.g. after a requeue) without duplicating
+	// resources.
+	Provision(ctx context.Context, tenant *tenantv1alpha1.Tenant) (provisionID string, err error)
+
+	// Update applies a version change to an already-provisioned backend in place, instead
+	// of the caller deleting and recreating it.
+	Update(ctx context.Context, provisionID, newVersion string) error
+
+	// Deprovision tears down everything Provision created for provisionID.
+	Deprovision(ctx context.Context, provisionID string) error
+
+	// Status reports the current state of provisionID.
+	Status(ctx context.Context, provisionID string) (Status, error)
+}
+
+// Set maps a tenant's Spec.Provisioner name to the Provisioner that handles it.
+type Set map[string]Provisioner
+
+// Get returns the Provisioner registered under name, defaulting to Kubernetes when name is
+// empty so tenants created before Spec.Provisioner existed keep working.
+func (s Set) Get(name string) (Provisioner, error) {
+	if name == "" {
+		name = Kubernetes
+	}
+	p, ok := s[name]
+	if !ok {
+		return nil, fmt.Errorf("no provisioner registered for %q", name)
+	}
+	return p, nil
+}
@@ -0,0 +1,115 @@
+package provisioner
+
+import (
+	"context"
+	"fmt"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+	"github.com/grg-automation/multi-saas-crm/tenant-orchestrator/pkg/credentials"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// SchemaAllocator manages per-tenant schemas and roles on a single shared Postgres
+// cluster. It is satisfied by a SQL-backed implementation against the shared cluster;
+// tests can supply a fake.
+type SchemaAllocator interface {
+	CreateSchema(ctx context.Context, schema, role, password string) error
+	DropSchema(ctx context.Context, schema, role string) error
+	SchemaExists(ctx context.Context, schema string) (bool, error)
+}
+
+// SharedClusterProvisioner puts a tenant's data in its own schema on a cluster shared with
+// other tenants, instead of a dedicated StatefulSet or RDS instance. It is meant for
+// low-tier tenants where per-tenant HA Postgres is not worth the resource cost.
+type SharedClusterProvisioner struct {
+	client    client.Client
+	allocator SchemaAllocator
+	host      string
+}
+
+// NewSharedClusterProvisioner creates a SharedClusterProvisioner that allocates schemas
+// through allocator on the shared cluster reachable at host, and mirrors credentials into
+// the cluster through c.
+func NewSharedClusterProvisioner(c client.Client, allocator SchemaAllocator, host string) *SharedClusterProvisioner {
+	return &SharedClusterProvisioner{client: c, allocator: allocator, host: host}
+}
+
+// Provision allocates a dedicated schema and role for tenant on the shared cluster and
+// mirrors the resulting credentials into the tenant's credentials Secret. The ProvisionID
+// is the schema name.
+func (p *SharedClusterProvisioner) Provision(ctx context.Context, tenant *tenantv1alpha1.Tenant) (string, error) {
+	namespace := fmt.Sprintf("tenant-%s", tenant.Name)
+	schema := fmt.Sprintf("tenant_%s", tenant.Name)
+	role := schema
+
+	exists, err := p.allocator.SchemaExists(ctx, schema)
+	if err != nil {
+		return "", fmt.Errorf("failed to check for existing schema %s: %w", schema, err)
+	}
+
+	password, err := credentials.Generate()
+	if err != nil {
+		return "", err
+	}
+
+	if !exists {
+		if err := p.allocator.CreateSchema(ctx, schema, role, password); err != nil {
+			return "", fmt.Errorf("failed to create schema %s: %w", schema, err)
+		}
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-db-credentials", tenant.Name),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"tenant.rezenkai.com/name": tenant.Name,
+				"app.kubernetes.io/managed-by": "tenant-orchestrator",
+				"app.kubernetes.io/part-of": "tenant-infrastructure",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"username": []byte(role),
+			"password": []byte(password),
+			"database": []byte(schema),
+			"host":     []byte(p.host),
+		},
+	}
+	if err := p.client.Create(ctx, secret); err != nil && !errors.IsAlreadyExists(err) {
+		return "", err
+	}
+
+	tenant.Status.DatabaseStatus.ConnectionURL = fmt.Sprintf("%s:5432/%s?search_path=%s", p.host, schema, schema)
+
+	return schema, nil
+}
+
+// Update is a no-op: tenants on the shared cluster all run whatever Postgres version the
+// cluster itself runs, so there is nothing per-tenant to upgrade.
+func (p *SharedClusterProvisioner) Update(ctx context.Context, provisionID, newVersion string) error {
+	return nil
+}
+
+// Deprovision drops the schema and role identified by provisionID.
+func (p *SharedClusterProvisioner) Deprovision(ctx context.Context, provisionID string) error {
+	return p.allocator.DropSchema(ctx, provisionID, provisionID)
+}
+
+// Status reports whether provisionID's schema still exists on the shared cluster.
+func (p *SharedClusterProvisioner) Status(ctx context.Context, provisionID string) (Status, error) {
+	exists, err := p.allocator.SchemaExists(ctx, provisionID)
+	if err != nil {
+		return Status{}, err
+	}
+	if !exists {
+		return Status{Phase: "NotFound"}, nil
+	}
+	return Status{
+		Phase:         "Ready",
+		ConnectionURL: fmt.Sprintf("%s:5432/%s?search_path=%s", p.host, provisionID, provisionID),
+	}, nil
+}
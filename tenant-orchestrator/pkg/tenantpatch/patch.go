@@ -0,0 +1,103 @@
+// Package tenantpatch applies JSON merge-patch or JSON Patch (RFC 6902) documents to a
+// Tenant's spec, rejecting changes to immutable fields instead of failing the whole patch.
+package tenantpatch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+)
+
+// Content-Type values negotiated by the PATCH endpoint.
+const (
+	ContentTypeMergePatch = "application/merge-patch+json"
+	ContentTypeJSONPatch  = "application/json-patch+json"
+)
+
+// Diff reports which top-level spec fields a patch changed versus which were rejected for
+// touching an immutable field.
+type Diff struct {
+	Accepted []string `json:"accepted"`
+	Rejected []string `json:"rejected"`
+}
+
+// Apply decodes patchBody according to contentType and applies it to current, reverting
+// any change to an immutable field (organizationName, database.type) rather than failing
+// the request outright. The returned spec always has valid immutable fields.
+func Apply(current tenantv1alpha1.TenantSpec, patchBody []byte, contentType string) (tenantv1alpha1.TenantSpec, Diff, error) {
+	origJSON, err := json.Marshal(current)
+	if err != nil {
+		return current, Diff{}, fmt.Errorf("failed to marshal current spec: %w", err)
+	}
+
+	var modifiedJSON []byte
+	switch contentType {
+	case ContentTypeJSONPatch:
+		patch, err := jsonpatch.DecodePatch(patchBody)
+		if err != nil {
+			return current, Diff{}, fmt.Errorf("invalid JSON patch: %w", err)
+		}
+		modifiedJSON, err = patch.Apply(origJSON)
+		if err != nil {
+			return current, Diff{}, fmt.Errorf("failed to apply JSON patch: %w", err)
+		}
+	default:
+		modifiedJSON, err = jsonpatch.MergePatch(origJSON, patchBody)
+		if err != nil {
+			return current, Diff{}, fmt.Errorf("failed to apply merge patch: %w", err)
+		}
+	}
+
+	var modified tenantv1alpha1.TenantSpec
+	if err := json.Unmarshal(modifiedJSON, &modified); err != nil {
+		return current, Diff{}, fmt.Errorf("patched spec is not a valid Tenant spec: %w", err)
+	}
+
+	var rejected []string
+	if modified.OrganizationName != current.OrganizationName {
+		rejected = append(rejected, "organizationName")
+		modified.OrganizationName = current.OrganizationName
+	}
+	if modified.Database.Type != current.Database.Type {
+		rejected = append(rejected, "database.type")
+		modified.Database.Type = current.Database.Type
+	}
+
+	accepted, err := changedTopLevelFields(origJSON, modified)
+	if err != nil {
+		return current, Diff{}, err
+	}
+
+	return modified, Diff{Accepted: accepted, Rejected: rejected}, nil
+}
+
+// changedTopLevelFields compares origJSON against the final (immutable-reverted) spec and
+// returns the top-level JSON field names that actually changed.
+func changedTopLevelFields(origJSON []byte, final tenantv1alpha1.TenantSpec) ([]string, error) {
+	var origFields map[string]json.RawMessage
+	if err := json.Unmarshal(origJSON, &origFields); err != nil {
+		return nil, fmt.Errorf("failed to inspect original spec: %w", err)
+	}
+
+	finalJSON, err := json.Marshal(final)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal final spec: %w", err)
+	}
+	var finalFields map[string]json.RawMessage
+	if err := json.Unmarshal(finalJSON, &finalFields); err != nil {
+		return nil, fmt.Errorf("failed to inspect final spec: %w", err)
+	}
+
+	var changed []string
+	for field, finalVal := range finalFields {
+		if origVal, ok := origFields[field]; !ok || !bytes.Equal(origVal, finalVal) {
+			changed = append(changed, field)
+		}
+	}
+	sort.Strings(changed)
+	return changed, nil
+}
@@ -0,0 +1,119 @@
+package tenantpatch
+
+import (
+	"testing"
+
+	tenantv1alpha1 "github.com/grg-automation/multi-saas-crm/tenant-orchestrator/api/v1alpha1"
+)
+
+func baseSpec() tenantv1alpha1.TenantSpec {
+	return tenantv1alpha1.TenantSpec{
+		OrganizationName: "Acme Corp",
+		Tier:             "standard",
+		Database: tenantv1alpha1.DatabaseSpec{
+			Type:    "postgres",
+			Version: "15",
+		},
+	}
+}
+
+func TestApply_ImmutableFieldRejectedLegalFieldAccepted(t *testing.T) {
+	current := baseSpec()
+	patch := []byte(`{"organizationName":"Evil Corp","tier":"premium"}`)
+
+	modified, diff, err := Apply(current, patch, ContentTypeMergePatch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if modified.OrganizationName != current.OrganizationName {
+		t.Errorf("expected organizationName to stay %q, got %q", current.OrganizationName, modified.OrganizationName)
+	}
+	if modified.Tier != "premium" {
+		t.Errorf("expected tier to become %q, got %q", "premium", modified.Tier)
+	}
+
+	if !containsString(diff.Rejected, "organizationName") {
+		t.Errorf("expected organizationName in Rejected, got %v", diff.Rejected)
+	}
+	if !containsString(diff.Accepted, "tier") {
+		t.Errorf("expected tier in Accepted, got %v", diff.Accepted)
+	}
+	if containsString(diff.Accepted, "organizationName") {
+		t.Errorf("organizationName must not also appear in Accepted, got %v", diff.Accepted)
+	}
+}
+
+func TestApply_DatabaseTypeImmutable(t *testing.T) {
+	current := baseSpec()
+	patch := []byte(`{"database":{"type":"mysql"}}`)
+
+	modified, diff, err := Apply(current, patch, ContentTypeMergePatch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if modified.Database.Type != current.Database.Type {
+		t.Errorf("expected database.type to stay %q, got %q", current.Database.Type, modified.Database.Type)
+	}
+	if !containsString(diff.Rejected, "database.type") {
+		t.Errorf("expected database.type in Rejected, got %v", diff.Rejected)
+	}
+}
+
+func TestApply_MergePatchContentType(t *testing.T) {
+	current := baseSpec()
+	patch := []byte(`{"tier":"premium"}`)
+
+	modified, _, err := Apply(current, patch, ContentTypeMergePatch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if modified.Tier != "premium" {
+		t.Errorf("expected tier %q, got %q", "premium", modified.Tier)
+	}
+}
+
+func TestApply_JSONPatchContentType(t *testing.T) {
+	current := baseSpec()
+	patch := []byte(`[{"op":"replace","path":"/tier","value":"premium"}]`)
+
+	modified, _, err := Apply(current, patch, ContentTypeJSONPatch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if modified.Tier != "premium" {
+		t.Errorf("expected tier %q, got %q", "premium", modified.Tier)
+	}
+}
+
+func TestApply_InvalidJSONPatch(t *testing.T) {
+	current := baseSpec()
+	patch := []byte(`not valid json`)
+
+	if _, _, err := Apply(current, patch, ContentTypeJSONPatch); err == nil {
+		t.Fatal("expected an error for an invalid JSON patch document")
+	}
+}
+
+func TestApply_NoOpPatchReportsNothing(t *testing.T) {
+	current := baseSpec()
+	patch := []byte(`{}`)
+
+	_, diff, err := Apply(current, patch, ContentTypeMergePatch)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(diff.Accepted) != 0 || len(diff.Rejected) != 0 {
+		t.Errorf("expected no accepted/rejected fields for a no-op patch, got %+v", diff)
+	}
+}
+
+func containsString(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}